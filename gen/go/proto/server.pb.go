@@ -10,6 +10,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -88,9 +89,15 @@ type GetChoicesRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// TODO(rbtz): allow passing theme for jokes
 	// A UUID for the user's session.
 	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// Optional ID of the theme to draw both jokes from.
+	ThemeId string `protobuf:"bytes,2,opt,name=theme_id,json=themeId,proto3" json:"theme_id,omitempty"`
+	// Optional text of the theme to draw both jokes from. Ignored if theme_id
+	// is set.
+	Theme string `protobuf:"bytes,3,opt,name=theme,proto3" json:"theme,omitempty"`
+	// Optional theme set, e.g. "v2", that both jokes must belong to.
+	ThemeSet string `protobuf:"bytes,4,opt,name=theme_set,json=themeSet,proto3" json:"theme_set,omitempty"`
 }
 
 func (x *GetChoicesRequest) Reset() {
@@ -132,6 +139,27 @@ func (x *GetChoicesRequest) GetSessionId() string {
 	return ""
 }
 
+func (x *GetChoicesRequest) GetThemeId() string {
+	if x != nil {
+		return x.ThemeId
+	}
+	return ""
+}
+
+func (x *GetChoicesRequest) GetTheme() string {
+	if x != nil {
+		return x.Theme
+	}
+	return ""
+}
+
+func (x *GetChoicesRequest) GetThemeSet() string {
+	if x != nil {
+		return x.ThemeSet
+	}
+	return ""
+}
+
 // GetChoicesResponse is a response to the GetChoicesRequest.
 type GetChoicesResponse struct {
 	state         protoimpl.MessageState
@@ -146,6 +174,8 @@ type GetChoicesResponse struct {
 	LeftJoke string `protobuf:"bytes,3,opt,name=left_joke,json=leftJoke,proto3" json:"left_joke,omitempty"`
 	// Text of the right joke.
 	RightJoke string `protobuf:"bytes,4,opt,name=right_joke,json=rightJoke,proto3" json:"right_joke,omitempty"`
+	// ID of the theme.
+	ThemeId string `protobuf:"bytes,5,opt,name=theme_id,json=themeId,proto3" json:"theme_id,omitempty"`
 }
 
 func (x *GetChoicesResponse) Reset() {
@@ -208,6 +238,13 @@ func (x *GetChoicesResponse) GetRightJoke() string {
 	return ""
 }
 
+func (x *GetChoicesResponse) GetThemeId() string {
+	if x != nil {
+		return x.ThemeId
+	}
+	return ""
+}
+
 // RateChoicesRequest to rate the presented jokes.
 type RateChoicesRequest struct {
 	state         protoimpl.MessageState
@@ -220,6 +257,8 @@ type RateChoicesRequest struct {
 	Winner Winner `protobuf:"varint,2,opt,name=winner,proto3,enum=choices.v1.Winner" json:"winner,omitempty"`
 	// Known jokes.
 	Known Winner `protobuf:"varint,3,opt,name=known,proto3,enum=choices.v1.Winner" json:"known,omitempty"`
+	// Session the pair was served to; must match GetChoicesRequest.session_id.
+	SessionId string `protobuf:"bytes,4,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 }
 
 func (x *RateChoicesRequest) Reset() {
@@ -275,17 +314,96 @@ func (x *RateChoicesRequest) GetKnown() Winner {
 	return Winner_UNSPECIFIED
 }
 
+func (x *RateChoicesRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+// JokeInfo contains the generation parameters of a joke.
+type JokeInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Public model name, as in LeaderboardEntry.model.
+	Model string `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"`
+	// Generation policy.
+	Policy string `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+	// Theme set of the joke.
+	ThemeSet string `protobuf:"bytes,3,opt,name=theme_set,json=themeSet,proto3" json:"theme_set,omitempty"`
+}
+
+func (x *JokeInfo) Reset() {
+	*x = JokeInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_server_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JokeInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JokeInfo) ProtoMessage() {}
+
+func (x *JokeInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_server_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JokeInfo.ProtoReflect.Descriptor instead.
+func (*JokeInfo) Descriptor() ([]byte, []int) {
+	return file_proto_server_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *JokeInfo) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *JokeInfo) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+func (x *JokeInfo) GetThemeSet() string {
+	if x != nil {
+		return x.ThemeSet
+	}
+	return ""
+}
+
 // RateChoicesResponse is a response to the RateChoicesRequest.
 type RateChoicesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Generation parameters of the left joke.
+	LeftJoke *JokeInfo `protobuf:"bytes,1,opt,name=left_joke,json=leftJoke,proto3" json:"left_joke,omitempty"`
+	// Generation parameters of the right joke.
+	RightJoke *JokeInfo `protobuf:"bytes,2,opt,name=right_joke,json=rightJoke,proto3" json:"right_joke,omitempty"`
 }
 
 func (x *RateChoicesResponse) Reset() {
 	*x = RateChoicesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_server_proto_msgTypes[3]
+		mi := &file_proto_server_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -298,7 +416,7 @@ func (x *RateChoicesResponse) String() string {
 func (*RateChoicesResponse) ProtoMessage() {}
 
 func (x *RateChoicesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_server_proto_msgTypes[3]
+	mi := &file_proto_server_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -311,20 +429,52 @@ func (x *RateChoicesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RateChoicesResponse.ProtoReflect.Descriptor instead.
 func (*RateChoicesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_server_proto_rawDescGZIP(), []int{3}
+	return file_proto_server_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RateChoicesResponse) GetLeftJoke() *JokeInfo {
+	if x != nil {
+		return x.LeftJoke
+	}
+	return nil
 }
 
-// GetLeaderboardRequest is a request to get the leaderboard.
+func (x *RateChoicesResponse) GetRightJoke() *JokeInfo {
+	if x != nil {
+		return x.RightJoke
+	}
+	return nil
+}
+
+// GetLeaderboardRequest is a request to get the leaderboard. Without filters
+// the latest precomputed leaderboard is returned, otherwise it is computed
+// from the matching votes, with fewer bootstrap samples for the confidence
+// intervals.
 type GetLeaderboardRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Only count votes where both jokes belong to this theme set. Unknown
+	// theme sets are rejected with INVALID_ARGUMENT.
+	ThemeSet string `protobuf:"bytes,1,opt,name=theme_set,json=themeSet,proto3" json:"theme_set,omitempty"`
+	// Only count votes where both jokes were generated with this policy.
+	// Unknown policies are rejected with INVALID_ARGUMENT.
+	Policy string `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+	// Only count votes on pairs shown at or after this time, rounded down to
+	// the start of its UTC day.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// Only count votes on pairs shown before this time, rounded up to the
+	// start of the next UTC day.
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Skip votes where the user already knew either joke.
+	ExcludeKnown bool `protobuf:"varint,5,opt,name=exclude_known,json=excludeKnown,proto3" json:"exclude_known,omitempty"`
 }
 
 func (x *GetLeaderboardRequest) Reset() {
 	*x = GetLeaderboardRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_server_proto_msgTypes[4]
+		mi := &file_proto_server_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -337,7 +487,7 @@ func (x *GetLeaderboardRequest) String() string {
 func (*GetLeaderboardRequest) ProtoMessage() {}
 
 func (x *GetLeaderboardRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_server_proto_msgTypes[4]
+	mi := &file_proto_server_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -350,7 +500,42 @@ func (x *GetLeaderboardRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLeaderboardRequest.ProtoReflect.Descriptor instead.
 func (*GetLeaderboardRequest) Descriptor() ([]byte, []int) {
-	return file_proto_server_proto_rawDescGZIP(), []int{4}
+	return file_proto_server_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetLeaderboardRequest) GetThemeSet() string {
+	if x != nil {
+		return x.ThemeSet
+	}
+	return ""
+}
+
+func (x *GetLeaderboardRequest) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+func (x *GetLeaderboardRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *GetLeaderboardRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *GetLeaderboardRequest) GetExcludeKnown() bool {
+	if x != nil {
+		return x.ExcludeKnown
+	}
+	return false
 }
 
 // LeaderboardEntry contains the model name and its Bradley-Terry rating.
@@ -378,7 +563,7 @@ type LeaderboardEntry struct {
 func (x *LeaderboardEntry) Reset() {
 	*x = LeaderboardEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_server_proto_msgTypes[5]
+		mi := &file_proto_server_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -391,7 +576,7 @@ func (x *LeaderboardEntry) String() string {
 func (*LeaderboardEntry) ProtoMessage() {}
 
 func (x *LeaderboardEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_server_proto_msgTypes[5]
+	mi := &file_proto_server_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -404,7 +589,7 @@ func (x *LeaderboardEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LeaderboardEntry.ProtoReflect.Descriptor instead.
 func (*LeaderboardEntry) Descriptor() ([]byte, []int) {
-	return file_proto_server_proto_rawDescGZIP(), []int{5}
+	return file_proto_server_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *LeaderboardEntry) GetModel() string {
@@ -484,12 +669,16 @@ type GetLeaderboardResponse struct {
 	unknownFields protoimpl.UnknownFields
 
 	Entries []*LeaderboardEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	// ID of the snapshot; empty for leaderboards computed on the fly.
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// Time the leaderboard was computed.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 }
 
 func (x *GetLeaderboardResponse) Reset() {
 	*x = GetLeaderboardResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_server_proto_msgTypes[6]
+		mi := &file_proto_server_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -502,7 +691,7 @@ func (x *GetLeaderboardResponse) String() string {
 func (*GetLeaderboardResponse) ProtoMessage() {}
 
 func (x *GetLeaderboardResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_server_proto_msgTypes[6]
+	mi := &file_proto_server_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -515,7 +704,7 @@ func (x *GetLeaderboardResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLeaderboardResponse.ProtoReflect.Descriptor instead.
 func (*GetLeaderboardResponse) Descriptor() ([]byte, []int) {
-	return file_proto_server_proto_rawDescGZIP(), []int{6}
+	return file_proto_server_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetLeaderboardResponse) GetEntries() []*LeaderboardEntry {
@@ -525,30 +714,53 @@ func (x *GetLeaderboardResponse) GetEntries() []*LeaderboardEntry {
 	return nil
 }
 
-// GetTopJokesRequest is a request to get the top jokes.
-type GetTopJokesRequest struct {
+func (x *GetLeaderboardResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetLeaderboardResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// ListLeaderboardSnapshotsRequest is a request to list leaderboard snapshots.
+type ListLeaderboardSnapshotsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Maximum number of snapshots to return. Defaults to 100.
+	PageSize uint32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Token from a previous ListLeaderboardSnapshotsResponse.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Only list snapshots created at or after this time.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// Only list snapshots created before this time.
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
 }
 
-func (x *GetTopJokesRequest) Reset() {
-	*x = GetTopJokesRequest{}
+func (x *ListLeaderboardSnapshotsRequest) Reset() {
+	*x = ListLeaderboardSnapshotsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_server_proto_msgTypes[7]
+		mi := &file_proto_server_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetTopJokesRequest) String() string {
+func (x *ListLeaderboardSnapshotsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTopJokesRequest) ProtoMessage() {}
+func (*ListLeaderboardSnapshotsRequest) ProtoMessage() {}
 
-func (x *GetTopJokesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_server_proto_msgTypes[7]
+func (x *ListLeaderboardSnapshotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_server_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -559,40 +771,68 @@ func (x *GetTopJokesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTopJokesRequest.ProtoReflect.Descriptor instead.
-func (*GetTopJokesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_server_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ListLeaderboardSnapshotsRequest.ProtoReflect.Descriptor instead.
+func (*ListLeaderboardSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_server_proto_rawDescGZIP(), []int{8}
 }
 
-// TopJokesEntry contains the rank and text of the joke.
-type TopJokesEntry struct {
+func (x *ListLeaderboardSnapshotsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListLeaderboardSnapshotsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListLeaderboardSnapshotsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ListLeaderboardSnapshotsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+// LeaderboardSnapshot summarizes a stored leaderboard.
+type LeaderboardSnapshot struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Rank of the joke.
-	Rank uint64 `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
-	// Text of the joke.
-	Text string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"` // TODO(rbtz): add model name?
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Number of ranked models.
+	Models uint32 `protobuf:"varint,3,opt,name=models,proto3" json:"models,omitempty"`
 }
 
-func (x *TopJokesEntry) Reset() {
-	*x = TopJokesEntry{}
+func (x *LeaderboardSnapshot) Reset() {
+	*x = LeaderboardSnapshot{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_server_proto_msgTypes[8]
+		mi := &file_proto_server_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TopJokesEntry) String() string {
+func (x *LeaderboardSnapshot) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TopJokesEntry) ProtoMessage() {}
+func (*LeaderboardSnapshot) ProtoMessage() {}
 
-func (x *TopJokesEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_server_proto_msgTypes[8]
+func (x *LeaderboardSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_server_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -603,51 +843,60 @@ func (x *TopJokesEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TopJokesEntry.ProtoReflect.Descriptor instead.
-func (*TopJokesEntry) Descriptor() ([]byte, []int) {
-	return file_proto_server_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use LeaderboardSnapshot.ProtoReflect.Descriptor instead.
+func (*LeaderboardSnapshot) Descriptor() ([]byte, []int) {
+	return file_proto_server_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *TopJokesEntry) GetRank() uint64 {
+func (x *LeaderboardSnapshot) GetId() string {
 	if x != nil {
-		return x.Rank
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *TopJokesEntry) GetText() string {
+func (x *LeaderboardSnapshot) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Text
+		return x.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-// GetTopJokesResponse contains the top jokes.
-type GetTopJokesResponse struct {
+func (x *LeaderboardSnapshot) GetModels() uint32 {
+	if x != nil {
+		return x.Models
+	}
+	return 0
+}
+
+// ListLeaderboardSnapshotsResponse contains a page of snapshots.
+type ListLeaderboardSnapshotsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Entries []*TopJokesEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Snapshots []*LeaderboardSnapshot `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	// Token for the next page; empty on the last page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
-func (x *GetTopJokesResponse) Reset() {
-	*x = GetTopJokesResponse{}
+func (x *ListLeaderboardSnapshotsResponse) Reset() {
+	*x = ListLeaderboardSnapshotsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_server_proto_msgTypes[9]
+		mi := &file_proto_server_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetTopJokesResponse) String() string {
+func (x *ListLeaderboardSnapshotsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTopJokesResponse) ProtoMessage() {}
+func (*ListLeaderboardSnapshotsResponse) ProtoMessage() {}
 
-func (x *GetTopJokesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_server_proto_msgTypes[9]
+func (x *ListLeaderboardSnapshotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_server_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -658,167 +907,2257 @@ func (x *GetTopJokesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTopJokesResponse.ProtoReflect.Descriptor instead.
-func (*GetTopJokesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_server_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ListLeaderboardSnapshotsResponse.ProtoReflect.Descriptor instead.
+func (*ListLeaderboardSnapshotsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_server_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GetTopJokesResponse) GetEntries() []*TopJokesEntry {
+func (x *ListLeaderboardSnapshotsResponse) GetSnapshots() []*LeaderboardSnapshot {
 	if x != nil {
-		return x.Entries
+		return x.Snapshots
 	}
 	return nil
 }
 
-var File_proto_server_proto protoreflect.FileDescriptor
-
-var file_proto_server_proto_rawDesc = []byte{
-	0x0a, 0x12, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31,
-	0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e,
-	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64,
-	0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0x32, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x49, 0x64, 0x22, 0x76, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x68, 0x65,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x12,
-	0x1b, 0x0a, 0x09, 0x6c, 0x65, 0x66, 0x74, 0x5f, 0x6a, 0x6f, 0x6b, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x6c, 0x65, 0x66, 0x74, 0x4a, 0x6f, 0x6b, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
-	0x72, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x6a, 0x6f, 0x6b, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x72, 0x69, 0x67, 0x68, 0x74, 0x4a, 0x6f, 0x6b, 0x65, 0x22, 0x7f, 0x0a, 0x12, 0x52,
-	0x61, 0x74, 0x65, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0,
-	0x41, 0x02, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2a, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73,
-	0x2e, 0x76, 0x31, 0x2e, 0x57, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x6e,
-	0x65, 0x72, 0x12, 0x28, 0x0a, 0x05, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x12, 0x2e, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57,
-	0x69, 0x6e, 0x6e, 0x65, 0x72, 0x52, 0x05, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x22, 0x15, 0x0a, 0x13,
-	0x52, 0x61, 0x74, 0x65, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x17, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xc4, 0x02, 0x0a,
-	0x10, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x1d, 0x0a,
-	0x0a, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x5f, 0x67, 0x6f, 0x6f, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x09, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x47, 0x6f, 0x6f, 0x64, 0x12, 0x1b, 0x0a, 0x09,
-	0x76, 0x6f, 0x74, 0x65, 0x73, 0x5f, 0x62, 0x61, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x08, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x42, 0x61, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x65, 0x77,
-	0x6d, 0x61, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b,
-	0x6e, 0x65, 0x77, 0x6d, 0x61, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6e,
-	0x65, 0x77, 0x6d, 0x61, 0x6e, 0x43, 0x49, 0x4c, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x0d, 0x6e, 0x65, 0x77, 0x6d, 0x61, 0x6e, 0x43, 0x49, 0x4c, 0x6f, 0x77, 0x65,
-	0x72, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x77, 0x6d, 0x61, 0x6e, 0x43, 0x49, 0x55, 0x70, 0x70,
-	0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x6e, 0x65, 0x77, 0x6d, 0x61, 0x6e,
-	0x43, 0x49, 0x55, 0x70, 0x70, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6c, 0x6f, 0x53, 0x63,
-	0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x65, 0x6c, 0x6f, 0x53, 0x63,
-	0x6f, 0x72, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6c, 0x6f, 0x43, 0x49, 0x4c, 0x6f, 0x77, 0x65,
-	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x65, 0x6c, 0x6f, 0x43, 0x49, 0x4c, 0x6f,
-	0x77, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6c, 0x6f, 0x43, 0x49, 0x55, 0x70, 0x70, 0x65,
-	0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x65, 0x6c, 0x6f, 0x43, 0x49, 0x55, 0x70,
-	0x70, 0x65, 0x72, 0x22, 0x50, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a,
-	0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
-	0x2e, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x64,
-	0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e,
-	0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x4a,
-	0x6f, 0x6b, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x37, 0x0a, 0x0d, 0x54,
-	0x6f, 0x70, 0x4a, 0x6f, 0x6b, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04,
-	0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b,
-	0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x74, 0x65, 0x78, 0x74, 0x22, 0x4a, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x4a, 0x6f,
-	0x6b, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x65,
-	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
-	0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x4a, 0x6f, 0x6b,
-	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
-	0x2a, 0x42, 0x0a, 0x06, 0x57, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e,
-	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4e,
-	0x4f, 0x4e, 0x45, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x45, 0x46, 0x54, 0x10, 0x02, 0x12,
-	0x09, 0x0a, 0x05, 0x52, 0x49, 0x47, 0x48, 0x54, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x42, 0x4f,
-	0x54, 0x48, 0x10, 0x04, 0x32, 0xb2, 0x03, 0x0a, 0x05, 0x41, 0x72, 0x65, 0x6e, 0x61, 0x12, 0x5f,
-	0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x63,
-	0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x6f,
-	0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x68,
-	0x6f, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x6f, 0x69,
-	0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x12, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x0c, 0x12, 0x0a, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x12,
-	0x6f, 0x0a, 0x0b, 0x52, 0x61, 0x74, 0x65, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x12, 0x1e,
-	0x2e, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x65,
-	0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
-	0x2e, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x65,
-	0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x3a, 0x01, 0x2a, 0x22, 0x14, 0x2f, 0x76, 0x31, 0x2f,
-	0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x61, 0x74, 0x65,
-	0x12, 0x70, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61,
-	0x72, 0x64, 0x12, 0x21, 0x2e, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72,
-	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x11, 0x12, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61,
-	0x72, 0x64, 0x12, 0x65, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x4a, 0x6f, 0x6b, 0x65,
-	0x73, 0x12, 0x1e, 0x2e, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x54, 0x6f, 0x70, 0x4a, 0x6f, 0x6b, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x54, 0x6f, 0x70, 0x4a, 0x6f, 0x6b, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x12, 0x0d, 0x2f, 0x76, 0x31, 0x2f,
-	0x74, 0x6f, 0x70, 0x2d, 0x6a, 0x6f, 0x6b, 0x65, 0x73, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x53, 0x61, 0x76, 0x65, 0x54, 0x68, 0x65, 0x52,
-	0x62, 0x74, 0x7a, 0x2f, 0x68, 0x75, 0x6d, 0x6f, 0x72, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f,
-	0x2f, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x68, 0x6f, 0x69,
-	0x63, 0x65, 0x73, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *ListLeaderboardSnapshotsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
 }
 
-var (
-	file_proto_server_proto_rawDescOnce sync.Once
-	file_proto_server_proto_rawDescData = file_proto_server_proto_rawDesc
-)
+// GetLeaderboardSnapshotRequest is a request to get a leaderboard snapshot.
+type GetLeaderboardSnapshotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func file_proto_server_proto_rawDescGZIP() []byte {
-	file_proto_server_proto_rawDescOnce.Do(func() {
-		file_proto_server_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_server_proto_rawDescData)
-	})
-	return file_proto_server_proto_rawDescData
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-var file_proto_server_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_server_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+func (x *GetLeaderboardSnapshotRequest) Reset() {
+	*x = GetLeaderboardSnapshotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_server_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLeaderboardSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaderboardSnapshotRequest) ProtoMessage() {}
+
+func (x *GetLeaderboardSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_server_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeaderboardSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*GetLeaderboardSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_proto_server_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetLeaderboardSnapshotRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// DiffLeaderboardSnapshotsRequest is a request to compare two snapshots.
+type DiffLeaderboardSnapshotsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The older snapshot.
+	BaseId string `protobuf:"bytes,1,opt,name=base_id,json=baseId,proto3" json:"base_id,omitempty"`
+	// The newer snapshot.
+	TargetId string `protobuf:"bytes,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+}
+
+func (x *DiffLeaderboardSnapshotsRequest) Reset() {
+	*x = DiffLeaderboardSnapshotsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_server_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffLeaderboardSnapshotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffLeaderboardSnapshotsRequest) ProtoMessage() {}
+
+func (x *DiffLeaderboardSnapshotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_server_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffLeaderboardSnapshotsRequest.ProtoReflect.Descriptor instead.
+func (*DiffLeaderboardSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_server_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DiffLeaderboardSnapshotsRequest) GetBaseId() string {
+	if x != nil {
+		return x.BaseId
+	}
+	return ""
+}
+
+func (x *DiffLeaderboardSnapshotsRequest) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+// LeaderboardDiffEntry describes how a model changed between two snapshots.
+// Ranks are 1-based by Elo score, 0 if the model is absent from a snapshot.
+type LeaderboardDiffEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Model      string `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"`
+	BaseRank   uint32 `protobuf:"varint,2,opt,name=base_rank,json=baseRank,proto3" json:"base_rank,omitempty"`
+	TargetRank uint32 `protobuf:"varint,3,opt,name=target_rank,json=targetRank,proto3" json:"target_rank,omitempty"`
+	// Positive when the model moved up.
+	RankChange       int32   `protobuf:"varint,4,opt,name=rank_change,json=rankChange,proto3" json:"rank_change,omitempty"`
+	EloScoreDelta    float64 `protobuf:"fixed64,5,opt,name=elo_score_delta,json=eloScoreDelta,proto3" json:"elo_score_delta,omitempty"`
+	NewmanScoreDelta float64 `protobuf:"fixed64,6,opt,name=newman_score_delta,json=newmanScoreDelta,proto3" json:"newman_score_delta,omitempty"`
+	VotesDelta       int64   `protobuf:"varint,7,opt,name=votes_delta,json=votesDelta,proto3" json:"votes_delta,omitempty"`
+	// The model is only present in the target snapshot.
+	Added bool `protobuf:"varint,8,opt,name=added,proto3" json:"added,omitempty"`
+	// The model is only present in the base snapshot.
+	Removed bool `protobuf:"varint,9,opt,name=removed,proto3" json:"removed,omitempty"`
+}
+
+func (x *LeaderboardDiffEntry) Reset() {
+	*x = LeaderboardDiffEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_server_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaderboardDiffEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardDiffEntry) ProtoMessage() {}
+
+func (x *LeaderboardDiffEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_server_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardDiffEntry.ProtoReflect.Descriptor instead.
+func (*LeaderboardDiffEntry) Descriptor() ([]byte, []int) {
+	return file_proto_server_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *LeaderboardDiffEntry) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *LeaderboardDiffEntry) GetBaseRank() uint32 {
+	if x != nil {
+		return x.BaseRank
+	}
+	return 0
+}
+
+func (x *LeaderboardDiffEntry) GetTargetRank() uint32 {
+	if x != nil {
+		return x.TargetRank
+	}
+	return 0
+}
+
+func (x *LeaderboardDiffEntry) GetRankChange() int32 {
+	if x != nil {
+		return x.RankChange
+	}
+	return 0
+}
+
+func (x *LeaderboardDiffEntry) GetEloScoreDelta() float64 {
+	if x != nil {
+		return x.EloScoreDelta
+	}
+	return 0
+}
+
+func (x *LeaderboardDiffEntry) GetNewmanScoreDelta() float64 {
+	if x != nil {
+		return x.NewmanScoreDelta
+	}
+	return 0
+}
+
+func (x *LeaderboardDiffEntry) GetVotesDelta() int64 {
+	if x != nil {
+		return x.VotesDelta
+	}
+	return 0
+}
+
+func (x *LeaderboardDiffEntry) GetAdded() bool {
+	if x != nil {
+		return x.Added
+	}
+	return false
+}
+
+func (x *LeaderboardDiffEntry) GetRemoved() bool {
+	if x != nil {
+		return x.Removed
+	}
+	return false
+}
+
+// DiffLeaderboardSnapshotsResponse contains per-model changes ordered by the
+// target rank.
+type DiffLeaderboardSnapshotsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Base    *LeaderboardSnapshot    `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Target  *LeaderboardSnapshot    `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	Entries []*LeaderboardDiffEntry `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *DiffLeaderboardSnapshotsResponse) Reset() {
+	*x = DiffLeaderboardSnapshotsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_server_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffLeaderboardSnapshotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffLeaderboardSnapshotsResponse) ProtoMessage() {}
+
+func (x *DiffLeaderboardSnapshotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_server_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffLeaderboardSnapshotsResponse.ProtoReflect.Descriptor instead.
+func (*DiffLeaderboardSnapshotsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_server_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DiffLeaderboardSnapshotsResponse) GetBase() *LeaderboardSnapshot {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *DiffLeaderboardSnapshotsResponse) GetTarget() *LeaderboardSnapshot {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *DiffLeaderboardSnapshotsResponse) GetEntries() []*LeaderboardDiffEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// GetModelHistoryRequest is a request to get the history of a model.
+type GetModelHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Public model name.
+	Model string `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"`
+	// Only include snapshots created at or after this time.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// Only include snapshots created before this time.
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (x *GetModelHistoryRequest) Reset() {
+	*x = GetModelHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_server_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetModelHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetModelHistoryRequest) ProtoMessage() {}
+
+func (x *GetModelHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_server_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetModelHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetModelHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_server_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetModelHistoryRequest) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *GetModelHistoryRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *GetModelHistoryRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+// ModelHistoryPoint is a model's standing in a single snapshot.
+type ModelHistoryPoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SnapshotId string                 `protobuf:"bytes,1,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// 1-based rank by Elo score.
+	Rank  uint32            `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`
+	Entry *LeaderboardEntry `protobuf:"bytes,4,opt,name=entry,proto3" json:"entry,omitempty"`
+}
+
+func (x *ModelHistoryPoint) Reset() {
+	*x = ModelHistoryPoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_server_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModelHistoryPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModelHistoryPoint) ProtoMessage() {}
+
+func (x *ModelHistoryPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_server_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModelHistoryPoint.ProtoReflect.Descriptor instead.
+func (*ModelHistoryPoint) Descriptor() ([]byte, []int) {
+	return file_proto_server_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ModelHistoryPoint) GetSnapshotId() string {
+	if x != nil {
+		return x.SnapshotId
+	}
+	return ""
+}
+
+func (x *ModelHistoryPoint) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ModelHistoryPoint) GetRank() uint32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *ModelHistoryPoint) GetEntry() *LeaderboardEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+// GetModelHistoryResponse contains the model's history, oldest first. Only
+// the 1000 most recent snapshots in the requested range are included.
+type GetModelHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Points []*ModelHistoryPoint `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
+}
+
+func (x *GetModelHistoryResponse) Reset() {
+	*x = GetModelHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_server_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetModelHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetModelHistoryResponse) ProtoMessage() {}
+
+func (x *GetModelHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_server_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetModelHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetModelHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_server_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetModelHistoryResponse) GetPoints() []*ModelHistoryPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+// HeadToHeadRecord counts votes between two models from model_a's side.
+type HeadToHeadRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Total votes, including both-bad ones.
+	Votes uint64 `protobuf:"varint,1,opt,name=votes,proto3" json:"votes,omitempty"`
+	// Votes for model_a's joke.
+	Wins uint64 `protobuf:"varint,2,opt,name=wins,proto3" json:"wins,omitempty"`
+	// Votes for model_b's joke.
+	Losses uint64 `protobuf:"varint,3,opt,name=losses,proto3" json:"losses,omitempty"`
+	// Votes where both jokes were liked.
+	Ties uint64 `protobuf:"varint,4,opt,name=ties,proto3" json:"ties,omitempty"`
+	// Votes where neither joke was liked.
+	BothBad uint64 `protobuf:"varint,5,opt,name=both_bad,json=bothBad,proto3" json:"both_bad,omitempty"`
+	// (wins + ties / 2) / (wins + losses + ties). Both-bad votes are ignored,
+	// as in the ratings.
+	WinRate float64 `protobuf:"fixed64,6,opt,name=win_rate,json=winRate,proto3" json:"win_rate,omitempty"`
+}
+
+func (x *HeadToHeadRecord) Reset() {
+	*x = HeadToHeadRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_server_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeadToHeadRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeadToHeadRecord) ProtoMessage() {}
+
+func (x *HeadToHeadRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_server_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeadToHeadRecord.ProtoReflect.Descriptor instead.
+func (*HeadToHeadRecord) Descriptor() ([]byte, []int) {
+	return file_proto_server_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *HeadToHeadRecord) GetVotes() uint64 {
+	if x != nil {
+		return x.Votes
+	}
+	return 0
+}
+
+func (x *HeadToHeadRecord) GetWins() uint64 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *HeadToHeadRecord) GetLosses() uint64 {
+	if x != nil {
+		return x.Losses
+	}
+	return 0
+}
+
+func (x *HeadToHeadRecord) GetTies() uint64 {
+	if x != nil {
+		return x.Ties
+	}
+	return 0
+}
+
+func (x *HeadToHeadRecord) GetBothBad() uint64 {
+	if x != nil {
+		return x.BothBad
+	}
+	return 0
+}
+
+func (x *HeadToHeadRecord) GetWinRate() float64 {
+	if x != nil {
+		return x.WinRate
+	}
+	return 0
+}
+
+// ThemeHeadToHead is the part of a head-to-head record from one theme.
+type ThemeHeadToHead struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ThemeId string            `protobuf:"bytes,1,opt,name=theme_id,json=themeId,proto3" json:"theme_id,omitempty"`
+	Theme   string            `protobuf:"bytes,2,opt,name=theme,proto3" json:"theme,omitempty"`
+	Record  *HeadToHeadRecord `protobuf:"bytes,3,opt,name=record,proto3" json:"record,omitempty"`
+}
+
+func (x *ThemeHeadToHead) Reset() {
+	*x = ThemeHeadToHead{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_server_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThemeHeadToHead) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThemeHeadToHead) ProtoMessage() {}
+
+func (x *ThemeHeadToHead) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_server_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThemeHeadToHead.ProtoReflect.Descriptor instead.
+func (*ThemeHeadToHead) Descriptor() ([]byte, []int) {
+	return file_proto_server_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ThemeHeadToHead) GetThemeId() string {
+	if x != nil {
+		return x.ThemeId
+	}
+	return ""
+}
+
+func (x *ThemeHeadToHead) GetTheme() string {
+	if x != nil {
+		return x.Theme
+	}
+	return ""
+}
+
+func (x *ThemeHeadToHead) GetRecord() *HeadToHeadRecord {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+// HeadToHeadExample is a rated pair of jokes by model_a and model_b.
+type HeadToHeadExample struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ThemeId string `protobuf:"bytes,1,opt,name=theme_id,json=themeId,proto3" json:"theme_id,omitempty"`
+	Theme   string `protobuf:"bytes,2,opt,name=theme,proto3" json:"theme,omitempty"`
+	// Joke by model_a.
+	JokeA string `protobuf:"bytes,3,opt,name=joke_a,json=jokeA,proto3" json:"joke_a,omitempty"`
+	// Joke by model_b.
+	JokeB string `protobuf:"bytes,4,opt,name=joke_b,json=jokeB,proto3" json:"joke_b,omitempty"`
+	// The vote, where LEFT means joke_a and RIGHT means joke_b.
+	Winner  Winner                 `protobuf:"varint,5,opt,name=winner,proto3,enum=choices.v1.Winner" json:"winner,omitempty"`
+	RatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=rated_at,json=ratedAt,proto3" json:"rated_at,omitempty"`
+}
+
+func (x *HeadToHeadExample) Reset() {
+	*x = HeadToHeadExample{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_server_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeadToHeadExample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeadToHeadExample) ProtoMessage() {}
+
+func (x *HeadToHeadExample) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_server_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeadToHeadExample.ProtoReflect.Descriptor instead.
+func (*HeadToHeadExample) Descriptor() ([]byte, []int) {
+	return file_proto_server_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *HeadToHeadExample) GetThemeId() string {
+	if x != nil {
+		return x.ThemeId
+	}
+	return ""
+}
+
+func (x *HeadToHeadExample) GetTheme() string {
+	if x != nil {
+		return x.Theme
+	}
+	return ""
+}
+
+func (x *HeadToHeadExample) GetJokeA() string {
+	if x != nil {
+		return x.JokeA
+	}
+	return ""
+}
+
+func (x *HeadToHeadExample) GetJokeB() string {
+	if x != nil {
+		return x.JokeB
+	}
+	return ""
+}
+
+func (x *HeadToHeadExample) GetWinner() Winner {
+	if x != nil {
+		return x.Winner
+	}
+	return Winner_UNSPECIFIED
+}
+
+func (x *HeadToHeadExample) GetRatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RatedAt
+	}
+	return nil
+}
+
+// HeadToHead summarizes the votes between two models.
+type HeadToHead struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ModelA string            `protobuf:"bytes,1,opt,name=model_a,json=modelA,proto3" json:"model_a,omitempty"`
+	ModelB string            `protobuf:"bytes,2,opt,name=model_b,json=modelB,proto3" json:"model_b,omitempty"`
+	Record *HeadToHeadRecord `protobuf:"bytes,3,opt,name=record,proto3" json:"record,omitempty"`
+	// Per-theme breakdown, most voted theme first.
+	Themes []*ThemeHeadToHead `protobuf:"bytes,4,rep,name=themes,proto3" json:"themes,omitempty"`
+	// Most recently rated pairs, newest first.
+	Examples []*HeadToHeadExample `protobuf:"bytes,5,rep,name=examples,proto3" json:"examples,omitempty"`
+}
+
+func (x *HeadToHead) Reset() {
+	*x = HeadToHead{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_server_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeadToHead) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeadToHead) ProtoMessage() {}
+
+func (x *HeadToHead) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_server_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeadToHead.ProtoReflect.Descriptor instead.
+func (*HeadToHead) Descriptor() ([]byte, []int) {
+	return file_proto_server_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *HeadToHead) GetModelA() string {
+	if x != nil {
+		return x.ModelA
+	}
+	return ""
+}
+
+func (x *HeadToHead) GetModelB() string {
+	if x != nil {
+		return x.ModelB
+	}
+	return ""
+}
+
+func (x *HeadToHead) GetRecord() *HeadToHeadRecord {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+func (x *HeadToHead) GetThemes() []*ThemeHeadToHead {
+	if x != nil {
+		return x.Themes
+	}
+	return nil
+}
+
+func (x *HeadToHead) GetExamples() []*HeadToHeadExample {
+	if x != nil {
+		return x.Examples
+	}
+	return nil
+}
+
+// GetHeadToHeadRequest is a request to compare two models. Filters work as in
+// GetLeaderboardRequest.
+type GetHeadToHeadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ModelA       string                 `protobuf:"bytes,1,opt,name=model_a,json=modelA,proto3" json:"model_a,omitempty"`
+	ModelB       string                 `protobuf:"bytes,2,opt,name=model_b,json=modelB,proto3" json:"model_b,omitempty"`
+	ThemeSet     string                 `protobuf:"bytes,3,opt,name=theme_set,json=themeSet,proto3" json:"theme_set,omitempty"`
+	Policy       string                 `protobuf:"bytes,4,opt,name=policy,proto3" json:"policy,omitempty"`
+	StartTime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	ExcludeKnown bool                   `protobuf:"varint,7,opt,name=exclude_known,json=excludeKnown,proto3" json:"exclude_known,omitempty"`
+	// Number of example pairs to return. Defaults to 3, at most 10.
+	MaxExamples uint32 `protobuf:"varint,8,opt,name=max_examples,json=maxExamples,proto3" json:"max_examples,omitempty"`
+}
+
+func (x *GetHeadToHeadRequest) Reset() {
+	*x = GetHeadToHeadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_server_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHeadToHeadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHeadToHeadRequest) ProtoMessage() {}
+
+func (x *GetHeadToHeadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_server_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHeadToHeadRequest.ProtoReflect.Descriptor instead.
+func (*GetHeadToHeadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_server_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetHeadToHeadRequest) GetModelA() string {
+	if x != nil {
+		return x.ModelA
+	}
+	return ""
+}
+
+func (x *GetHeadToHeadRequest) GetModelB() string {
+	if x != nil {
+		return x.ModelB
+	}
+	return ""
+}
+
+func (x *GetHeadToHeadRequest) GetThemeSet() string {
+	if x != nil {
+		return x.ThemeSet
+	}
+	return ""
+}
+
+func (x *GetHeadToHeadRequest) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+func (x *GetHeadToHeadRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *GetHeadToHeadRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *GetHeadToHeadRequest) GetExcludeKnown() bool {
+	if x != nil {
+		return x.ExcludeKnown
+	}
+	return false
+}
+
+func (x *GetHeadToHeadRequest) GetMaxExamples() uint32 {
+	if x != nil {
+		return x.MaxExamples
+	}
+	return 0
+}
+
+// GetHeadToHeadResponse contains the votes between the two models.
+type GetHeadToHeadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HeadToHead *HeadToHead `protobuf:"bytes,1,opt,name=head_to_head,json=headToHead,proto3" json:"head_to_head,omitempty"`
+}
+
+func (x *GetHeadToHeadResponse) Reset() {
+	*x = GetHeadToHeadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_server_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHeadToHeadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHeadToHeadResponse) ProtoMessage() {}
+
+func (x *GetHeadToHeadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_server_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHeadToHeadResponse.ProtoReflect.Descriptor instead.
+func (*GetHeadToHeadResponse) Descriptor() ([]byte, []int) {
+	return file_proto_server_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetHeadToHeadResponse) GetHeadToHead() *HeadToHead {
+	if x != nil {
+		return x.HeadToHead
+	}
+	return nil
+}
+
+// GetHeadToHeadMatrixRequest is a request to compare all models. Filters work
+// as in GetLeaderboardRequest.
+type GetHeadToHeadMatrixRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ThemeSet     string                 `protobuf:"bytes,1,opt,name=theme_set,json=themeSet,proto3" json:"theme_set,omitempty"`
+	Policy       string                 `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+	StartTime    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	ExcludeKnown bool                   `protobuf:"varint,5,opt,name=exclude_known,json=excludeKnown,proto3" json:"exclude_known,omitempty"`
+	// Number of example pairs to return per model pair. Defaults to 3, at
+	// most 10.
+	MaxExamples uint32 `protobuf:"varint,6,opt,name=max_examples,json=maxExamples,proto3" json:"max_examples,omitempty"`
+}
+
+func (x *GetHeadToHeadMatrixRequest) Reset() {
+	*x = GetHeadToHeadMatrixRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_server_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHeadToHeadMatrixRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHeadToHeadMatrixRequest) ProtoMessage() {}
+
+func (x *GetHeadToHeadMatrixRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_server_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHeadToHeadMatrixRequest.ProtoReflect.Descriptor instead.
+func (*GetHeadToHeadMatrixRequest) Descriptor() ([]byte, []int) {
+	return file_proto_server_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetHeadToHeadMatrixRequest) GetThemeSet() string {
+	if x != nil {
+		return x.ThemeSet
+	}
+	return ""
+}
+
+func (x *GetHeadToHeadMatrixRequest) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+func (x *GetHeadToHeadMatrixRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *GetHeadToHeadMatrixRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *GetHeadToHeadMatrixRequest) GetExcludeKnown() bool {
+	if x != nil {
+		return x.ExcludeKnown
+	}
+	return false
+}
+
+func (x *GetHeadToHeadMatrixRequest) GetMaxExamples() uint32 {
+	if x != nil {
+		return x.MaxExamples
+	}
+	return 0
+}
+
+// GetHeadToHeadMatrixResponse contains every pair of models that met at least
+// once. Each pair is listed once with model_a sorted before model_b.
+type GetHeadToHeadMatrixResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// All models that appear in pairs, sorted.
+	Models []string      `protobuf:"bytes,1,rep,name=models,proto3" json:"models,omitempty"`
+	Pairs  []*HeadToHead `protobuf:"bytes,2,rep,name=pairs,proto3" json:"pairs,omitempty"`
+}
+
+func (x *GetHeadToHeadMatrixResponse) Reset() {
+	*x = GetHeadToHeadMatrixResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_server_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHeadToHeadMatrixResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHeadToHeadMatrixResponse) ProtoMessage() {}
+
+func (x *GetHeadToHeadMatrixResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_server_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHeadToHeadMatrixResponse.ProtoReflect.Descriptor instead.
+func (*GetHeadToHeadMatrixResponse) Descriptor() ([]byte, []int) {
+	return file_proto_server_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetHeadToHeadMatrixResponse) GetModels() []string {
+	if x != nil {
+		return x.Models
+	}
+	return nil
+}
+
+func (x *GetHeadToHeadMatrixResponse) GetPairs() []*HeadToHead {
+	if x != nil {
+		return x.Pairs
+	}
+	return nil
+}
+
+// GetPositionBiasRequest is a request for the position bias report. Filters
+// work as in GetLeaderboardRequest.
+type GetPositionBiasRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ThemeSet     string                 `protobuf:"bytes,1,opt,name=theme_set,json=themeSet,proto3" json:"theme_set,omitempty"`
+	Policy       string                 `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+	StartTime    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	ExcludeKnown bool                   `protobuf:"varint,5,opt,name=exclude_known,json=excludeKnown,proto3" json:"exclude_known,omitempty"`
+	// Only count votes on pairs with a randomized side assignment. Before
+	// sides were randomized, the side was correlated with the model.
+	RandomizedOnly bool `protobuf:"varint,6,opt,name=randomized_only,json=randomizedOnly,proto3" json:"randomized_only,omitempty"`
+}
+
+func (x *GetPositionBiasRequest) Reset() {
+	*x = GetPositionBiasRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_server_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPositionBiasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPositionBiasRequest) ProtoMessage() {}
+
+func (x *GetPositionBiasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_server_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPositionBiasRequest.ProtoReflect.Descriptor instead.
+func (*GetPositionBiasRequest) Descriptor() ([]byte, []int) {
+	return file_proto_server_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetPositionBiasRequest) GetThemeSet() string {
+	if x != nil {
+		return x.ThemeSet
+	}
+	return ""
+}
+
+func (x *GetPositionBiasRequest) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+func (x *GetPositionBiasRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *GetPositionBiasRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *GetPositionBiasRequest) GetExcludeKnown() bool {
+	if x != nil {
+		return x.ExcludeKnown
+	}
+	return false
+}
+
+func (x *GetPositionBiasRequest) GetRandomizedOnly() bool {
+	if x != nil {
+		return x.RandomizedOnly
+	}
+	return false
+}
+
+// PositionBiasEntry shows how a model fares on each side of the screen.
+type PositionBiasEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Model string `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"`
+	// Votes with the model's joke on the left, from the model's side.
+	Left *HeadToHeadRecord `protobuf:"bytes,2,opt,name=left,proto3" json:"left,omitempty"`
+	// Votes with the model's joke on the right, from the model's side.
+	Right *HeadToHeadRecord `protobuf:"bytes,3,opt,name=right,proto3" json:"right,omitempty"`
+	// Bradley-Terry score of the model. Scores sum to one.
+	Score float64 `protobuf:"fixed64,4,opt,name=score,proto3" json:"score,omitempty"`
+	// Bradley-Terry score of the model with the position bias removed.
+	// Scores sum to one.
+	CorrectedScore float64 `protobuf:"fixed64,5,opt,name=corrected_score,json=correctedScore,proto3" json:"corrected_score,omitempty"`
+}
+
+func (x *PositionBiasEntry) Reset() {
+	*x = PositionBiasEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_server_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PositionBiasEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PositionBiasEntry) ProtoMessage() {}
+
+func (x *PositionBiasEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_server_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PositionBiasEntry.ProtoReflect.Descriptor instead.
+func (*PositionBiasEntry) Descriptor() ([]byte, []int) {
+	return file_proto_server_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *PositionBiasEntry) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *PositionBiasEntry) GetLeft() *HeadToHeadRecord {
+	if x != nil {
+		return x.Left
+	}
+	return nil
+}
+
+func (x *PositionBiasEntry) GetRight() *HeadToHeadRecord {
+	if x != nil {
+		return x.Right
+	}
+	return nil
+}
+
+func (x *PositionBiasEntry) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *PositionBiasEntry) GetCorrectedScore() float64 {
+	if x != nil {
+		return x.CorrectedScore
+	}
+	return 0
+}
+
+// GetPositionBiasResponse is the position bias report.
+type GetPositionBiasResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Votes from the left joke's side, including pairs of jokes by the same
+	// model.
+	Left *HeadToHeadRecord `protobuf:"bytes,1,opt,name=left,proto3" json:"left,omitempty"`
+	// Standard error of the left win rate.
+	LeftWinRateStderr float64 `protobuf:"fixed64,2,opt,name=left_win_rate_stderr,json=leftWinRateStderr,proto3" json:"left_win_rate_stderr,omitempty"`
+	// Fitted odds multiplier of the left side: a left joke with score a beats a
+	// right joke with score b with probability advantage*a / (advantage*a + b).
+	// One means there is no position bias.
+	LeftAdvantage float64 `protobuf:"fixed64,3,opt,name=left_advantage,json=leftAdvantage,proto3" json:"left_advantage,omitempty"`
+	// Models ordered by name.
+	Entries []*PositionBiasEntry `protobuf:"bytes,4,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *GetPositionBiasResponse) Reset() {
+	*x = GetPositionBiasResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_server_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPositionBiasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPositionBiasResponse) ProtoMessage() {}
+
+func (x *GetPositionBiasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_server_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPositionBiasResponse.ProtoReflect.Descriptor instead.
+func (*GetPositionBiasResponse) Descriptor() ([]byte, []int) {
+	return file_proto_server_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetPositionBiasResponse) GetLeft() *HeadToHeadRecord {
+	if x != nil {
+		return x.Left
+	}
+	return nil
+}
+
+func (x *GetPositionBiasResponse) GetLeftWinRateStderr() float64 {
+	if x != nil {
+		return x.LeftWinRateStderr
+	}
+	return 0
+}
+
+func (x *GetPositionBiasResponse) GetLeftAdvantage() float64 {
+	if x != nil {
+		return x.LeftAdvantage
+	}
+	return 0
+}
+
+func (x *GetPositionBiasResponse) GetEntries() []*PositionBiasEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// GetTopJokesRequest is a request to get the top jokes.
+type GetTopJokesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Maximum number of jokes to return. Defaults to 50, at most 1000.
+	PageSize uint32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Token from a previous response to get the next page.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Only return jokes by this model, named as in LeaderboardEntry.model.
+	Model string `protobuf:"bytes,3,opt,name=model,proto3" json:"model,omitempty"`
+	// Only return jokes for the theme with this ID.
+	ThemeId string `protobuf:"bytes,4,opt,name=theme_id,json=themeId,proto3" json:"theme_id,omitempty"`
+	// Only return jokes for the theme with this text. Ignored if theme_id is
+	// set.
+	Theme string `protobuf:"bytes,5,opt,name=theme,proto3" json:"theme,omitempty"`
+}
+
+func (x *GetTopJokesRequest) Reset() {
+	*x = GetTopJokesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_server_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTopJokesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTopJokesRequest) ProtoMessage() {}
+
+func (x *GetTopJokesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_server_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopJokesRequest.ProtoReflect.Descriptor instead.
+func (*GetTopJokesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_server_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetTopJokesRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetTopJokesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetTopJokesRequest) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *GetTopJokesRequest) GetThemeId() string {
+	if x != nil {
+		return x.ThemeId
+	}
+	return ""
+}
+
+func (x *GetTopJokesRequest) GetTheme() string {
+	if x != nil {
+		return x.Theme
+	}
+	return ""
+}
+
+// TopJokesEntry contains the rank and text of the joke.
+type TopJokesEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Rank of the joke among all ranked jokes, regardless of filters.
+	Rank uint64 `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	// Text of the joke.
+	Text    string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	JokeId  string `protobuf:"bytes,3,opt,name=joke_id,json=jokeId,proto3" json:"joke_id,omitempty"`
+	ThemeId string `protobuf:"bytes,4,opt,name=theme_id,json=themeId,proto3" json:"theme_id,omitempty"`
+	Theme   string `protobuf:"bytes,5,opt,name=theme,proto3" json:"theme,omitempty"`
+	// Model of the joke, named as in LeaderboardEntry.model.
+	Model  string `protobuf:"bytes,6,opt,name=model,proto3" json:"model,omitempty"`
+	Policy string `protobuf:"bytes,7,opt,name=policy,proto3" json:"policy,omitempty"`
+	// Bradley-Terry score of the joke. A joke with as many wins as losses
+	// scores about one.
+	Score        float64 `protobuf:"fixed64,8,opt,name=score,proto3" json:"score,omitempty"`
+	ScoreCiLower float64 `protobuf:"fixed64,9,opt,name=score_ci_lower,json=scoreCiLower,proto3" json:"score_ci_lower,omitempty"`
+	ScoreCiUpper float64 `protobuf:"fixed64,10,opt,name=score_ci_upper,json=scoreCiUpper,proto3" json:"score_ci_upper,omitempty"`
+	// Number of votes the score is based on.
+	Votes uint64 `protobuf:"varint,11,opt,name=votes,proto3" json:"votes,omitempty"`
+}
+
+func (x *TopJokesEntry) Reset() {
+	*x = TopJokesEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_server_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopJokesEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopJokesEntry) ProtoMessage() {}
+
+func (x *TopJokesEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_server_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopJokesEntry.ProtoReflect.Descriptor instead.
+func (*TopJokesEntry) Descriptor() ([]byte, []int) {
+	return file_proto_server_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *TopJokesEntry) GetRank() uint64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *TopJokesEntry) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *TopJokesEntry) GetJokeId() string {
+	if x != nil {
+		return x.JokeId
+	}
+	return ""
+}
+
+func (x *TopJokesEntry) GetThemeId() string {
+	if x != nil {
+		return x.ThemeId
+	}
+	return ""
+}
+
+func (x *TopJokesEntry) GetTheme() string {
+	if x != nil {
+		return x.Theme
+	}
+	return ""
+}
+
+func (x *TopJokesEntry) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *TopJokesEntry) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+func (x *TopJokesEntry) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *TopJokesEntry) GetScoreCiLower() float64 {
+	if x != nil {
+		return x.ScoreCiLower
+	}
+	return 0
+}
+
+func (x *TopJokesEntry) GetScoreCiUpper() float64 {
+	if x != nil {
+		return x.ScoreCiUpper
+	}
+	return 0
+}
+
+func (x *TopJokesEntry) GetVotes() uint64 {
+	if x != nil {
+		return x.Votes
+	}
+	return 0
+}
+
+// GetTopJokesResponse contains the top jokes.
+type GetTopJokesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*TopJokesEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	// Token to get the next page, empty on the last one.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *GetTopJokesResponse) Reset() {
+	*x = GetTopJokesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_server_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTopJokesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTopJokesResponse) ProtoMessage() {}
+
+func (x *GetTopJokesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_server_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopJokesResponse.ProtoReflect.Descriptor instead.
+func (*GetTopJokesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_server_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetTopJokesResponse) GetEntries() []*TopJokesEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetTopJokesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+var File_proto_server_proto protoreflect.FileDescriptor
+
+var file_proto_server_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31,
+	0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x80, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x5f,
+	0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x65, 0x6d, 0x65,
+	0x53, 0x65, 0x74, 0x22, 0x91, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x68, 0x6f, 0x69, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x68,
+	0x65, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x68, 0x65, 0x6d, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x65, 0x66, 0x74, 0x5f, 0x6a, 0x6f, 0x6b, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x65, 0x66, 0x74, 0x4a, 0x6f, 0x6b, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x72, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x6a, 0x6f, 0x6b, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x72, 0x69, 0x67, 0x68, 0x74, 0x4a, 0x6f, 0x6b, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x74, 0x68, 0x65, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x74, 0x68, 0x65, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x9e, 0x01, 0x0a, 0x12, 0x52, 0x61, 0x74, 0x65,
+	0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x13,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x2a, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x57, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x12,
+	0x28, 0x0a, 0x05, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12,
+	0x2e, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x69, 0x6e, 0x6e,
+	0x65, 0x72, 0x52, 0x05, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x55, 0x0a, 0x08, 0x4a, 0x6f, 0x6b, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x53, 0x65, 0x74, 0x22,
+	0x7d, 0x0a, 0x13, 0x52, 0x61, 0x74, 0x65, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x09, 0x6c, 0x65, 0x66, 0x74, 0x5f, 0x6a,
+	0x6f, 0x6b, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x68, 0x6f, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x6b, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x08, 0x6c, 0x65, 0x66, 0x74, 0x4a, 0x6f, 0x6b, 0x65, 0x12, 0x33, 0x0a, 0x0a, 0x72, 0x69, 0x67,
+	0x68, 0x74, 0x5f, 0x6a, 0x6f, 0x6b, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x6b, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x09, 0x72, 0x69, 0x67, 0x68, 0x74, 0x4a, 0x6f, 0x6b, 0x65, 0x22, 0xe3,
+	0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x68, 0x65, 0x6d,
+	0x65, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x65,
+	0x6d, 0x65, 0x53, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x39, 0x0a,
+	0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x6b, 0x6e, 0x6f, 0x77, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4b,
+	0x6e, 0x6f, 0x77, 0x6e, 0x22, 0xc4, 0x02, 0x0a, 0x10, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x76, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x5f, 0x67,
+	0x6f, 0x6f, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x76, 0x6f, 0x74, 0x65, 0x73,
+	0x47, 0x6f, 0x6f, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x5f, 0x62, 0x61,
+	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x42, 0x61,
+	0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x6d, 0x61, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x6d, 0x61, 0x6e, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x77, 0x6d, 0x61, 0x6e, 0x43, 0x49, 0x4c,
+	0x6f, 0x77, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x6e, 0x65, 0x77, 0x6d,
+	0x61, 0x6e, 0x43, 0x49, 0x4c, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x77,
+	0x6d, 0x61, 0x6e, 0x43, 0x49, 0x55, 0x70, 0x70, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0d, 0x6e, 0x65, 0x77, 0x6d, 0x61, 0x6e, 0x43, 0x49, 0x55, 0x70, 0x70, 0x65, 0x72, 0x12,
+	0x1a, 0x0a, 0x08, 0x65, 0x6c, 0x6f, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x08, 0x65, 0x6c, 0x6f, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65,
+	0x6c, 0x6f, 0x43, 0x49, 0x4c, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0a, 0x65, 0x6c, 0x6f, 0x43, 0x49, 0x4c, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x65,
+	0x6c, 0x6f, 0x43, 0x49, 0x55, 0x70, 0x70, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0a, 0x65, 0x6c, 0x6f, 0x43, 0x49, 0x55, 0x70, 0x70, 0x65, 0x72, 0x22, 0x9b, 0x01, 0x0a, 0x16,
+	0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x39,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xcf, 0x01, 0x0a, 0x1f, 0x4c, 0x69,
+	0x73, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x78, 0x0a, 0x13, 0x4c,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x22, 0x89, 0x01, 0x0a, 0x20, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x09, 0x73, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x09,
+	0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x34, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03,
+	0xe0, 0x41, 0x02, 0x52, 0x02, 0x69, 0x64, 0x22, 0x61, 0x0a, 0x1f, 0x44, 0x69, 0x66, 0x66, 0x4c,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x07, 0x62, 0x61,
+	0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02,
+	0x52, 0x06, 0x62, 0x61, 0x73, 0x65, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x09, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02,
+	0x52, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x22, 0xb2, 0x02, 0x0a, 0x14, 0x4c,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x44, 0x69, 0x66, 0x66, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x73,
+	0x65, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x62, 0x61,
+	0x73, 0x65, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x61, 0x6e, 0x6b, 0x5f,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x72, 0x61,
+	0x6e, 0x6b, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x65, 0x6c, 0x6f, 0x5f,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0d, 0x65, 0x6c, 0x6f, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x44, 0x65, 0x6c, 0x74, 0x61,
+	0x12, 0x2c, 0x0a, 0x12, 0x6e, 0x65, 0x77, 0x6d, 0x61, 0x6e, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x6e, 0x65,
+	0x77, 0x6d, 0x61, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x1f,
+	0x0a, 0x0b, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12,
+	0x14, 0x0a, 0x05, 0x61, 0x64, 0x64, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x61, 0x64, 0x64, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x22,
+	0xcc, 0x01, 0x0a, 0x20, 0x44, 0x69, 0x66, 0x66, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x68, 0x6f, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x12, 0x3a, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x44, 0x69, 0x66, 0x66,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0xa5,
+	0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x05, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x05, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65,
+	0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xb7, 0x01, 0x0a, 0x11, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x39, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x32, 0x0a, 0x05,
+	0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x68,
+	0x6f, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79,
+	0x22, 0x50, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x68,
+	0x6f, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x22, 0x9e, 0x01, 0x0a, 0x10, 0x48, 0x65, 0x61, 0x64, 0x54, 0x6f, 0x48, 0x65, 0x61,
+	0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x77, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x77, 0x69, 0x6e,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x73, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x6c, 0x6f, 0x73, 0x73, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x74, 0x69, 0x65, 0x73, 0x12, 0x19, 0x0a,
+	0x08, 0x62, 0x6f, 0x74, 0x68, 0x5f, 0x62, 0x61, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x62, 0x6f, 0x74, 0x68, 0x42, 0x61, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x77, 0x69, 0x6e, 0x5f,
+	0x72, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x77, 0x69, 0x6e, 0x52,
+	0x61, 0x74, 0x65, 0x22, 0x78, 0x0a, 0x0f, 0x54, 0x68, 0x65, 0x6d, 0x65, 0x48, 0x65, 0x61, 0x64,
+	0x54, 0x6f, 0x48, 0x65, 0x61, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x54, 0x6f, 0x48, 0x65, 0x61, 0x64, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x22, 0xd5, 0x01,
+	0x0a, 0x11, 0x48, 0x65, 0x61, 0x64, 0x54, 0x6f, 0x48, 0x65, 0x61, 0x64, 0x45, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x68, 0x65, 0x6d, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x6b, 0x65, 0x5f, 0x61, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x6b, 0x65, 0x41, 0x12, 0x15, 0x0a, 0x06, 0x6a,
+	0x6f, 0x6b, 0x65, 0x5f, 0x62, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x6b,
+	0x65, 0x42, 0x12, 0x2a, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x12, 0x2e, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x57, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x35,
+	0x0a, 0x08, 0x72, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x72, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xe4, 0x01, 0x0a, 0x0a, 0x48, 0x65, 0x61, 0x64, 0x54, 0x6f,
+	0x48, 0x65, 0x61, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x61, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x41, 0x12, 0x17, 0x0a,
+	0x07, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x42, 0x12, 0x34, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x54, 0x6f, 0x48, 0x65, 0x61, 0x64, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x52, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x33, 0x0a, 0x06,
+	0x74, 0x68, 0x65, 0x6d, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63,
+	0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x68, 0x65, 0x6d, 0x65, 0x48,
+	0x65, 0x61, 0x64, 0x54, 0x6f, 0x48, 0x65, 0x61, 0x64, 0x52, 0x06, 0x74, 0x68, 0x65, 0x6d, 0x65,
+	0x73, 0x12, 0x39, 0x0a, 0x08, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x48, 0x65, 0x61, 0x64, 0x54, 0x6f, 0x48, 0x65, 0x61, 0x64, 0x45, 0x78, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x52, 0x08, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x22, 0xc1, 0x02, 0x0a,
+	0x14, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x54, 0x6f, 0x48, 0x65, 0x61, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x07, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x61,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x06, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x41, 0x12, 0x1c, 0x0a, 0x07, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x62, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x42, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x53, 0x65, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x5f, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x12, 0x21, 0x0a,
+	0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73,
+	0x22, 0x51, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x54, 0x6f, 0x48, 0x65, 0x61,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0c, 0x68, 0x65, 0x61,
+	0x64, 0x5f, 0x74, 0x6f, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61,
+	0x64, 0x54, 0x6f, 0x48, 0x65, 0x61, 0x64, 0x52, 0x0a, 0x68, 0x65, 0x61, 0x64, 0x54, 0x6f, 0x48,
+	0x65, 0x61, 0x64, 0x22, 0x8b, 0x02, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x54,
+	0x6f, 0x48, 0x65, 0x61, 0x64, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x53, 0x65, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x5f, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0c, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x12, 0x21,
+	0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x73, 0x22, 0x63, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x54, 0x6f, 0x48, 0x65,
+	0x61, 0x64, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x06, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x12, 0x2c, 0x0a, 0x05, 0x70, 0x61, 0x69, 0x72,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x54, 0x6f, 0x48, 0x65, 0x61, 0x64, 0x52,
+	0x05, 0x70, 0x61, 0x69, 0x72, 0x73, 0x22, 0x8d, 0x02, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x53, 0x65, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x5f, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x12, 0x27, 0x0a,
+	0x0f, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6f, 0x6e, 0x6c, 0x79,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x69, 0x7a,
+	0x65, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0xce, 0x01, 0x0a, 0x11, 0x50, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x69, 0x61, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x12, 0x30, 0x0a, 0x04, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65,
+	0x61, 0x64, 0x54, 0x6f, 0x48, 0x65, 0x61, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x04,
+	0x6c, 0x65, 0x66, 0x74, 0x12, 0x32, 0x0a, 0x05, 0x72, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x48, 0x65, 0x61, 0x64, 0x54, 0x6f, 0x48, 0x65, 0x61, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x52, 0x05, 0x72, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x27,
+	0x0a, 0x0f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x22, 0xdc, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x04, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x48,
+	0x65, 0x61, 0x64, 0x54, 0x6f, 0x48, 0x65, 0x61, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52,
+	0x04, 0x6c, 0x65, 0x66, 0x74, 0x12, 0x2f, 0x0a, 0x14, 0x6c, 0x65, 0x66, 0x74, 0x5f, 0x77, 0x69,
+	0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x11, 0x6c, 0x65, 0x66, 0x74, 0x57, 0x69, 0x6e, 0x52, 0x61, 0x74, 0x65,
+	0x53, 0x74, 0x64, 0x65, 0x72, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x65, 0x66, 0x74, 0x5f, 0x61,
+	0x64, 0x76, 0x61, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d,
+	0x6c, 0x65, 0x66, 0x74, 0x41, 0x64, 0x76, 0x61, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x37, 0x0a,
+	0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x61, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x97, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x54, 0x6f,
+	0x70, 0x4a, 0x6f, 0x6b, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12,
+	0x19, 0x0a, 0x08, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x68,
+	0x65, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x68, 0x65, 0x6d, 0x65,
+	0x22, 0xa7, 0x02, 0x0a, 0x0d, 0x54, 0x6f, 0x70, 0x4a, 0x6f, 0x6b, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6a, 0x6f,
+	0x6b, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6a, 0x6f, 0x6b,
+	0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x68, 0x65, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x5f, 0x63, 0x69, 0x5f, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0c, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x43, 0x69, 0x4c, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x24,
+	0x0a, 0x0e, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x63, 0x69, 0x5f, 0x75, 0x70, 0x70, 0x65, 0x72,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x43, 0x69, 0x55,
+	0x70, 0x70, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x72, 0x0a, 0x13, 0x47, 0x65,
+	0x74, 0x54, 0x6f, 0x70, 0x4a, 0x6f, 0x6b, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x33, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x6f, 0x70, 0x4a, 0x6f, 0x6b, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x2a, 0x42,
+	0x0a, 0x06, 0x57, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e,
+	0x45, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x45, 0x46, 0x54, 0x10, 0x02, 0x12, 0x09, 0x0a,
+	0x05, 0x52, 0x49, 0x47, 0x48, 0x54, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x42, 0x4f, 0x54, 0x48,
+	0x10, 0x04, 0x32, 0xc3, 0x0b, 0x0a, 0x05, 0x41, 0x72, 0x65, 0x6e, 0x61, 0x12, 0x5f, 0x0a, 0x0a,
+	0x47, 0x65, 0x74, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x63, 0x68, 0x6f,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x6f, 0x69, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x68, 0x6f, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x12, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x0c, 0x12, 0x0a, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x6f, 0x0a,
+	0x0b, 0x52, 0x61, 0x74, 0x65, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x63,
+	0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x43, 0x68,
+	0x6f, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63,
+	0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x43, 0x68,
+	0x6f, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x19, 0x3a, 0x01, 0x2a, 0x22, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x68,
+	0x6f, 0x69, 0x63, 0x65, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x61, 0x74, 0x65, 0x12, 0x70,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x12, 0x21, 0x2e, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12,
+	0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x12, 0x98, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x12, 0x2b, 0x2e,
+	0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x63, 0x68, 0x6f,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b,
+	0x12, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x2f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x12, 0x8f, 0x01, 0x0a, 0x16,
+	0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x29, 0x2e, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x22, 0x2e, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f,
+	0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2f, 0x73,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xb3, 0x01,
+	0x0a, 0x18, 0x44, 0x69, 0x66, 0x66, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x12, 0x2b, 0x2e, 0x63, 0x68, 0x6f,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x4c, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x36, 0x12, 0x34, 0x2f,
+	0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2f, 0x73,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2f, 0x7b, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x69,
+	0x64, 0x7d, 0x2f, 0x64, 0x69, 0x66, 0x66, 0x2f, 0x7b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f,
+	0x69, 0x64, 0x7d, 0x12, 0x8a, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x22, 0x2e, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x68,
+	0x6f, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65,
+	0x6c, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x12, 0x26, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x2f, 0x7b, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x7d, 0x2f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x12, 0x8b, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x54, 0x6f, 0x48, 0x65,
+	0x61, 0x64, 0x12, 0x20, 0x2e, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x54, 0x6f, 0x48, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x54, 0x6f, 0x48, 0x65, 0x61, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x12,
+	0x2d, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x7b, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x61,
+	0x7d, 0x2f, 0x76, 0x73, 0x2f, 0x7b, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x62, 0x7d, 0x12, 0x8c,
+	0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x54, 0x6f, 0x48, 0x65, 0x61, 0x64,
+	0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x12, 0x26, 0x2e, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x54, 0x6f, 0x48, 0x65, 0x61,
+	0x64, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
+	0x2e, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x48,
+	0x65, 0x61, 0x64, 0x54, 0x6f, 0x48, 0x65, 0x61, 0x64, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12,
+	0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x2f, 0x68, 0x65, 0x61, 0x64, 0x2d, 0x74, 0x6f, 0x2d, 0x68, 0x65, 0x61, 0x64, 0x12, 0x81, 0x01,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x61,
+	0x73, 0x12, 0x22, 0x2e, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x61, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69,
+	0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x2f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x62, 0x69, 0x61,
+	0x73, 0x12, 0x65, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x4a, 0x6f, 0x6b, 0x65, 0x73,
+	0x12, 0x1e, 0x2e, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x6f, 0x70, 0x4a, 0x6f, 0x6b, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x6f, 0x70, 0x4a, 0x6f, 0x6b, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x12, 0x0d, 0x2f, 0x76, 0x31, 0x2f, 0x74,
+	0x6f, 0x70, 0x2d, 0x6a, 0x6f, 0x6b, 0x65, 0x73, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x53, 0x61, 0x76, 0x65, 0x54, 0x68, 0x65, 0x52, 0x62,
+	0x74, 0x7a, 0x2f, 0x68, 0x75, 0x6d, 0x6f, 0x72, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f,
+	0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x68, 0x6f, 0x69, 0x63,
+	0x65, 0x73, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_server_proto_rawDescOnce sync.Once
+	file_proto_server_proto_rawDescData = file_proto_server_proto_rawDesc
+)
+
+func file_proto_server_proto_rawDescGZIP() []byte {
+	file_proto_server_proto_rawDescOnce.Do(func() {
+		file_proto_server_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_server_proto_rawDescData)
+	})
+	return file_proto_server_proto_rawDescData
+}
+
+var file_proto_server_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto_server_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
 var file_proto_server_proto_goTypes = []any{
-	(Winner)(0),                    // 0: choices.v1.Winner
-	(*GetChoicesRequest)(nil),      // 1: choices.v1.GetChoicesRequest
-	(*GetChoicesResponse)(nil),     // 2: choices.v1.GetChoicesResponse
-	(*RateChoicesRequest)(nil),     // 3: choices.v1.RateChoicesRequest
-	(*RateChoicesResponse)(nil),    // 4: choices.v1.RateChoicesResponse
-	(*GetLeaderboardRequest)(nil),  // 5: choices.v1.GetLeaderboardRequest
-	(*LeaderboardEntry)(nil),       // 6: choices.v1.LeaderboardEntry
-	(*GetLeaderboardResponse)(nil), // 7: choices.v1.GetLeaderboardResponse
-	(*GetTopJokesRequest)(nil),     // 8: choices.v1.GetTopJokesRequest
-	(*TopJokesEntry)(nil),          // 9: choices.v1.TopJokesEntry
-	(*GetTopJokesResponse)(nil),    // 10: choices.v1.GetTopJokesResponse
+	(Winner)(0),                              // 0: choices.v1.Winner
+	(*GetChoicesRequest)(nil),                // 1: choices.v1.GetChoicesRequest
+	(*GetChoicesResponse)(nil),               // 2: choices.v1.GetChoicesResponse
+	(*RateChoicesRequest)(nil),               // 3: choices.v1.RateChoicesRequest
+	(*JokeInfo)(nil),                         // 4: choices.v1.JokeInfo
+	(*RateChoicesResponse)(nil),              // 5: choices.v1.RateChoicesResponse
+	(*GetLeaderboardRequest)(nil),            // 6: choices.v1.GetLeaderboardRequest
+	(*LeaderboardEntry)(nil),                 // 7: choices.v1.LeaderboardEntry
+	(*GetLeaderboardResponse)(nil),           // 8: choices.v1.GetLeaderboardResponse
+	(*ListLeaderboardSnapshotsRequest)(nil),  // 9: choices.v1.ListLeaderboardSnapshotsRequest
+	(*LeaderboardSnapshot)(nil),              // 10: choices.v1.LeaderboardSnapshot
+	(*ListLeaderboardSnapshotsResponse)(nil), // 11: choices.v1.ListLeaderboardSnapshotsResponse
+	(*GetLeaderboardSnapshotRequest)(nil),    // 12: choices.v1.GetLeaderboardSnapshotRequest
+	(*DiffLeaderboardSnapshotsRequest)(nil),  // 13: choices.v1.DiffLeaderboardSnapshotsRequest
+	(*LeaderboardDiffEntry)(nil),             // 14: choices.v1.LeaderboardDiffEntry
+	(*DiffLeaderboardSnapshotsResponse)(nil), // 15: choices.v1.DiffLeaderboardSnapshotsResponse
+	(*GetModelHistoryRequest)(nil),           // 16: choices.v1.GetModelHistoryRequest
+	(*ModelHistoryPoint)(nil),                // 17: choices.v1.ModelHistoryPoint
+	(*GetModelHistoryResponse)(nil),          // 18: choices.v1.GetModelHistoryResponse
+	(*HeadToHeadRecord)(nil),                 // 19: choices.v1.HeadToHeadRecord
+	(*ThemeHeadToHead)(nil),                  // 20: choices.v1.ThemeHeadToHead
+	(*HeadToHeadExample)(nil),                // 21: choices.v1.HeadToHeadExample
+	(*HeadToHead)(nil),                       // 22: choices.v1.HeadToHead
+	(*GetHeadToHeadRequest)(nil),             // 23: choices.v1.GetHeadToHeadRequest
+	(*GetHeadToHeadResponse)(nil),            // 24: choices.v1.GetHeadToHeadResponse
+	(*GetHeadToHeadMatrixRequest)(nil),       // 25: choices.v1.GetHeadToHeadMatrixRequest
+	(*GetHeadToHeadMatrixResponse)(nil),      // 26: choices.v1.GetHeadToHeadMatrixResponse
+	(*GetPositionBiasRequest)(nil),           // 27: choices.v1.GetPositionBiasRequest
+	(*PositionBiasEntry)(nil),                // 28: choices.v1.PositionBiasEntry
+	(*GetPositionBiasResponse)(nil),          // 29: choices.v1.GetPositionBiasResponse
+	(*GetTopJokesRequest)(nil),               // 30: choices.v1.GetTopJokesRequest
+	(*TopJokesEntry)(nil),                    // 31: choices.v1.TopJokesEntry
+	(*GetTopJokesResponse)(nil),              // 32: choices.v1.GetTopJokesResponse
+	(*timestamppb.Timestamp)(nil),            // 33: google.protobuf.Timestamp
 }
 var file_proto_server_proto_depIdxs = []int32{
 	0,  // 0: choices.v1.RateChoicesRequest.winner:type_name -> choices.v1.Winner
 	0,  // 1: choices.v1.RateChoicesRequest.known:type_name -> choices.v1.Winner
-	6,  // 2: choices.v1.GetLeaderboardResponse.entries:type_name -> choices.v1.LeaderboardEntry
-	9,  // 3: choices.v1.GetTopJokesResponse.entries:type_name -> choices.v1.TopJokesEntry
-	1,  // 4: choices.v1.Arena.GetChoices:input_type -> choices.v1.GetChoicesRequest
-	3,  // 5: choices.v1.Arena.RateChoices:input_type -> choices.v1.RateChoicesRequest
-	5,  // 6: choices.v1.Arena.GetLeaderboard:input_type -> choices.v1.GetLeaderboardRequest
-	8,  // 7: choices.v1.Arena.GetTopJokes:input_type -> choices.v1.GetTopJokesRequest
-	2,  // 8: choices.v1.Arena.GetChoices:output_type -> choices.v1.GetChoicesResponse
-	4,  // 9: choices.v1.Arena.RateChoices:output_type -> choices.v1.RateChoicesResponse
-	7,  // 10: choices.v1.Arena.GetLeaderboard:output_type -> choices.v1.GetLeaderboardResponse
-	10, // 11: choices.v1.Arena.GetTopJokes:output_type -> choices.v1.GetTopJokesResponse
-	8,  // [8:12] is the sub-list for method output_type
-	4,  // [4:8] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	4,  // 2: choices.v1.RateChoicesResponse.left_joke:type_name -> choices.v1.JokeInfo
+	4,  // 3: choices.v1.RateChoicesResponse.right_joke:type_name -> choices.v1.JokeInfo
+	33, // 4: choices.v1.GetLeaderboardRequest.start_time:type_name -> google.protobuf.Timestamp
+	33, // 5: choices.v1.GetLeaderboardRequest.end_time:type_name -> google.protobuf.Timestamp
+	7,  // 6: choices.v1.GetLeaderboardResponse.entries:type_name -> choices.v1.LeaderboardEntry
+	33, // 7: choices.v1.GetLeaderboardResponse.created_at:type_name -> google.protobuf.Timestamp
+	33, // 8: choices.v1.ListLeaderboardSnapshotsRequest.start_time:type_name -> google.protobuf.Timestamp
+	33, // 9: choices.v1.ListLeaderboardSnapshotsRequest.end_time:type_name -> google.protobuf.Timestamp
+	33, // 10: choices.v1.LeaderboardSnapshot.created_at:type_name -> google.protobuf.Timestamp
+	10, // 11: choices.v1.ListLeaderboardSnapshotsResponse.snapshots:type_name -> choices.v1.LeaderboardSnapshot
+	10, // 12: choices.v1.DiffLeaderboardSnapshotsResponse.base:type_name -> choices.v1.LeaderboardSnapshot
+	10, // 13: choices.v1.DiffLeaderboardSnapshotsResponse.target:type_name -> choices.v1.LeaderboardSnapshot
+	14, // 14: choices.v1.DiffLeaderboardSnapshotsResponse.entries:type_name -> choices.v1.LeaderboardDiffEntry
+	33, // 15: choices.v1.GetModelHistoryRequest.start_time:type_name -> google.protobuf.Timestamp
+	33, // 16: choices.v1.GetModelHistoryRequest.end_time:type_name -> google.protobuf.Timestamp
+	33, // 17: choices.v1.ModelHistoryPoint.created_at:type_name -> google.protobuf.Timestamp
+	7,  // 18: choices.v1.ModelHistoryPoint.entry:type_name -> choices.v1.LeaderboardEntry
+	17, // 19: choices.v1.GetModelHistoryResponse.points:type_name -> choices.v1.ModelHistoryPoint
+	19, // 20: choices.v1.ThemeHeadToHead.record:type_name -> choices.v1.HeadToHeadRecord
+	0,  // 21: choices.v1.HeadToHeadExample.winner:type_name -> choices.v1.Winner
+	33, // 22: choices.v1.HeadToHeadExample.rated_at:type_name -> google.protobuf.Timestamp
+	19, // 23: choices.v1.HeadToHead.record:type_name -> choices.v1.HeadToHeadRecord
+	20, // 24: choices.v1.HeadToHead.themes:type_name -> choices.v1.ThemeHeadToHead
+	21, // 25: choices.v1.HeadToHead.examples:type_name -> choices.v1.HeadToHeadExample
+	33, // 26: choices.v1.GetHeadToHeadRequest.start_time:type_name -> google.protobuf.Timestamp
+	33, // 27: choices.v1.GetHeadToHeadRequest.end_time:type_name -> google.protobuf.Timestamp
+	22, // 28: choices.v1.GetHeadToHeadResponse.head_to_head:type_name -> choices.v1.HeadToHead
+	33, // 29: choices.v1.GetHeadToHeadMatrixRequest.start_time:type_name -> google.protobuf.Timestamp
+	33, // 30: choices.v1.GetHeadToHeadMatrixRequest.end_time:type_name -> google.protobuf.Timestamp
+	22, // 31: choices.v1.GetHeadToHeadMatrixResponse.pairs:type_name -> choices.v1.HeadToHead
+	33, // 32: choices.v1.GetPositionBiasRequest.start_time:type_name -> google.protobuf.Timestamp
+	33, // 33: choices.v1.GetPositionBiasRequest.end_time:type_name -> google.protobuf.Timestamp
+	19, // 34: choices.v1.PositionBiasEntry.left:type_name -> choices.v1.HeadToHeadRecord
+	19, // 35: choices.v1.PositionBiasEntry.right:type_name -> choices.v1.HeadToHeadRecord
+	19, // 36: choices.v1.GetPositionBiasResponse.left:type_name -> choices.v1.HeadToHeadRecord
+	28, // 37: choices.v1.GetPositionBiasResponse.entries:type_name -> choices.v1.PositionBiasEntry
+	31, // 38: choices.v1.GetTopJokesResponse.entries:type_name -> choices.v1.TopJokesEntry
+	1,  // 39: choices.v1.Arena.GetChoices:input_type -> choices.v1.GetChoicesRequest
+	3,  // 40: choices.v1.Arena.RateChoices:input_type -> choices.v1.RateChoicesRequest
+	6,  // 41: choices.v1.Arena.GetLeaderboard:input_type -> choices.v1.GetLeaderboardRequest
+	9,  // 42: choices.v1.Arena.ListLeaderboardSnapshots:input_type -> choices.v1.ListLeaderboardSnapshotsRequest
+	12, // 43: choices.v1.Arena.GetLeaderboardSnapshot:input_type -> choices.v1.GetLeaderboardSnapshotRequest
+	13, // 44: choices.v1.Arena.DiffLeaderboardSnapshots:input_type -> choices.v1.DiffLeaderboardSnapshotsRequest
+	16, // 45: choices.v1.Arena.GetModelHistory:input_type -> choices.v1.GetModelHistoryRequest
+	23, // 46: choices.v1.Arena.GetHeadToHead:input_type -> choices.v1.GetHeadToHeadRequest
+	25, // 47: choices.v1.Arena.GetHeadToHeadMatrix:input_type -> choices.v1.GetHeadToHeadMatrixRequest
+	27, // 48: choices.v1.Arena.GetPositionBias:input_type -> choices.v1.GetPositionBiasRequest
+	30, // 49: choices.v1.Arena.GetTopJokes:input_type -> choices.v1.GetTopJokesRequest
+	2,  // 50: choices.v1.Arena.GetChoices:output_type -> choices.v1.GetChoicesResponse
+	5,  // 51: choices.v1.Arena.RateChoices:output_type -> choices.v1.RateChoicesResponse
+	8,  // 52: choices.v1.Arena.GetLeaderboard:output_type -> choices.v1.GetLeaderboardResponse
+	11, // 53: choices.v1.Arena.ListLeaderboardSnapshots:output_type -> choices.v1.ListLeaderboardSnapshotsResponse
+	8,  // 54: choices.v1.Arena.GetLeaderboardSnapshot:output_type -> choices.v1.GetLeaderboardResponse
+	15, // 55: choices.v1.Arena.DiffLeaderboardSnapshots:output_type -> choices.v1.DiffLeaderboardSnapshotsResponse
+	18, // 56: choices.v1.Arena.GetModelHistory:output_type -> choices.v1.GetModelHistoryResponse
+	24, // 57: choices.v1.Arena.GetHeadToHead:output_type -> choices.v1.GetHeadToHeadResponse
+	26, // 58: choices.v1.Arena.GetHeadToHeadMatrix:output_type -> choices.v1.GetHeadToHeadMatrixResponse
+	29, // 59: choices.v1.Arena.GetPositionBias:output_type -> choices.v1.GetPositionBiasResponse
+	32, // 60: choices.v1.Arena.GetTopJokes:output_type -> choices.v1.GetTopJokesResponse
+	50, // [50:61] is the sub-list for method output_type
+	39, // [39:50] is the sub-list for method input_type
+	39, // [39:39] is the sub-list for extension type_name
+	39, // [39:39] is the sub-list for extension extendee
+	0,  // [0:39] is the sub-list for field type_name
 }
 
 func init() { file_proto_server_proto_init() }
@@ -864,7 +3203,7 @@ func file_proto_server_proto_init() {
 			}
 		}
 		file_proto_server_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*RateChoicesResponse); i {
+			switch v := v.(*JokeInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -876,7 +3215,7 @@ func file_proto_server_proto_init() {
 			}
 		}
 		file_proto_server_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*GetLeaderboardRequest); i {
+			switch v := v.(*RateChoicesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -888,7 +3227,7 @@ func file_proto_server_proto_init() {
 			}
 		}
 		file_proto_server_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*LeaderboardEntry); i {
+			switch v := v.(*GetLeaderboardRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -900,7 +3239,7 @@ func file_proto_server_proto_init() {
 			}
 		}
 		file_proto_server_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*GetLeaderboardResponse); i {
+			switch v := v.(*LeaderboardEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -912,7 +3251,7 @@ func file_proto_server_proto_init() {
 			}
 		}
 		file_proto_server_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*GetTopJokesRequest); i {
+			switch v := v.(*GetLeaderboardResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -924,7 +3263,7 @@ func file_proto_server_proto_init() {
 			}
 		}
 		file_proto_server_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*TopJokesEntry); i {
+			switch v := v.(*ListLeaderboardSnapshotsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -936,6 +3275,270 @@ func file_proto_server_proto_init() {
 			}
 		}
 		file_proto_server_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*LeaderboardSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_server_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*ListLeaderboardSnapshotsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_server_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*GetLeaderboardSnapshotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_server_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*DiffLeaderboardSnapshotsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_server_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*LeaderboardDiffEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_server_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*DiffLeaderboardSnapshotsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_server_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*GetModelHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_server_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*ModelHistoryPoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_server_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*GetModelHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_server_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*HeadToHeadRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_server_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*ThemeHeadToHead); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_server_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*HeadToHeadExample); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_server_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*HeadToHead); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_server_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*GetHeadToHeadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_server_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*GetHeadToHeadResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_server_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*GetHeadToHeadMatrixRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_server_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*GetHeadToHeadMatrixResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_server_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPositionBiasRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_server_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*PositionBiasEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_server_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPositionBiasResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_server_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*GetTopJokesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_server_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*TopJokesEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_server_proto_msgTypes[31].Exporter = func(v any, i int) any {
 			switch v := v.(*GetTopJokesResponse); i {
 			case 0:
 				return &v.state
@@ -954,7 +3557,7 @@ func file_proto_server_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_server_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   10,
+			NumMessages:   32,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
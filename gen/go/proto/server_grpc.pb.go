@@ -19,10 +19,17 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Arena_GetChoices_FullMethodName     = "/choices.v1.Arena/GetChoices"
-	Arena_RateChoices_FullMethodName    = "/choices.v1.Arena/RateChoices"
-	Arena_GetLeaderboard_FullMethodName = "/choices.v1.Arena/GetLeaderboard"
-	Arena_GetTopJokes_FullMethodName    = "/choices.v1.Arena/GetTopJokes"
+	Arena_GetChoices_FullMethodName               = "/choices.v1.Arena/GetChoices"
+	Arena_RateChoices_FullMethodName              = "/choices.v1.Arena/RateChoices"
+	Arena_GetLeaderboard_FullMethodName           = "/choices.v1.Arena/GetLeaderboard"
+	Arena_ListLeaderboardSnapshots_FullMethodName = "/choices.v1.Arena/ListLeaderboardSnapshots"
+	Arena_GetLeaderboardSnapshot_FullMethodName   = "/choices.v1.Arena/GetLeaderboardSnapshot"
+	Arena_DiffLeaderboardSnapshots_FullMethodName = "/choices.v1.Arena/DiffLeaderboardSnapshots"
+	Arena_GetModelHistory_FullMethodName          = "/choices.v1.Arena/GetModelHistory"
+	Arena_GetHeadToHead_FullMethodName            = "/choices.v1.Arena/GetHeadToHead"
+	Arena_GetHeadToHeadMatrix_FullMethodName      = "/choices.v1.Arena/GetHeadToHeadMatrix"
+	Arena_GetPositionBias_FullMethodName          = "/choices.v1.Arena/GetPositionBias"
+	Arena_GetTopJokes_FullMethodName              = "/choices.v1.Arena/GetTopJokes"
 )
 
 // ArenaClient is the client API for Arena service.
@@ -30,6 +37,9 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 //
 // Arena service provides joke comparison functionalities.
+//
+// Every RPC that ranks, filters or reveals a model identifies it by its public
+// model name, LeaderboardEntry.model.
 type ArenaClient interface {
 	// Retrieves a pair of jokes for comparison.
 	GetChoices(ctx context.Context, in *GetChoicesRequest, opts ...grpc.CallOption) (*GetChoicesResponse, error)
@@ -37,6 +47,20 @@ type ArenaClient interface {
 	RateChoices(ctx context.Context, in *RateChoicesRequest, opts ...grpc.CallOption) (*RateChoicesResponse, error)
 	// Gets the leaderboard of joke models.
 	GetLeaderboard(ctx context.Context, in *GetLeaderboardRequest, opts ...grpc.CallOption) (*GetLeaderboardResponse, error)
+	// Lists stored leaderboard snapshots, newest first.
+	ListLeaderboardSnapshots(ctx context.Context, in *ListLeaderboardSnapshotsRequest, opts ...grpc.CallOption) (*ListLeaderboardSnapshotsResponse, error)
+	// Gets a leaderboard snapshot by its ID.
+	GetLeaderboardSnapshot(ctx context.Context, in *GetLeaderboardSnapshotRequest, opts ...grpc.CallOption) (*GetLeaderboardResponse, error)
+	// Compares two leaderboard snapshots.
+	DiffLeaderboardSnapshots(ctx context.Context, in *DiffLeaderboardSnapshotsRequest, opts ...grpc.CallOption) (*DiffLeaderboardSnapshotsResponse, error)
+	// Gets the score history of a model across leaderboard snapshots.
+	GetModelHistory(ctx context.Context, in *GetModelHistoryRequest, opts ...grpc.CallOption) (*GetModelHistoryResponse, error)
+	// Gets the votes between two models.
+	GetHeadToHead(ctx context.Context, in *GetHeadToHeadRequest, opts ...grpc.CallOption) (*GetHeadToHeadResponse, error)
+	// Gets the votes between every pair of models.
+	GetHeadToHeadMatrix(ctx context.Context, in *GetHeadToHeadMatrixRequest, opts ...grpc.CallOption) (*GetHeadToHeadMatrixResponse, error)
+	// Reports how much the screen side affects votes.
+	GetPositionBias(ctx context.Context, in *GetPositionBiasRequest, opts ...grpc.CallOption) (*GetPositionBiasResponse, error)
 	// Gets the top jokes.
 	GetTopJokes(ctx context.Context, in *GetTopJokesRequest, opts ...grpc.CallOption) (*GetTopJokesResponse, error)
 }
@@ -79,6 +103,76 @@ func (c *arenaClient) GetLeaderboard(ctx context.Context, in *GetLeaderboardRequ
 	return out, nil
 }
 
+func (c *arenaClient) ListLeaderboardSnapshots(ctx context.Context, in *ListLeaderboardSnapshotsRequest, opts ...grpc.CallOption) (*ListLeaderboardSnapshotsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLeaderboardSnapshotsResponse)
+	err := c.cc.Invoke(ctx, Arena_ListLeaderboardSnapshots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *arenaClient) GetLeaderboardSnapshot(ctx context.Context, in *GetLeaderboardSnapshotRequest, opts ...grpc.CallOption) (*GetLeaderboardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLeaderboardResponse)
+	err := c.cc.Invoke(ctx, Arena_GetLeaderboardSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *arenaClient) DiffLeaderboardSnapshots(ctx context.Context, in *DiffLeaderboardSnapshotsRequest, opts ...grpc.CallOption) (*DiffLeaderboardSnapshotsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffLeaderboardSnapshotsResponse)
+	err := c.cc.Invoke(ctx, Arena_DiffLeaderboardSnapshots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *arenaClient) GetModelHistory(ctx context.Context, in *GetModelHistoryRequest, opts ...grpc.CallOption) (*GetModelHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetModelHistoryResponse)
+	err := c.cc.Invoke(ctx, Arena_GetModelHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *arenaClient) GetHeadToHead(ctx context.Context, in *GetHeadToHeadRequest, opts ...grpc.CallOption) (*GetHeadToHeadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHeadToHeadResponse)
+	err := c.cc.Invoke(ctx, Arena_GetHeadToHead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *arenaClient) GetHeadToHeadMatrix(ctx context.Context, in *GetHeadToHeadMatrixRequest, opts ...grpc.CallOption) (*GetHeadToHeadMatrixResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHeadToHeadMatrixResponse)
+	err := c.cc.Invoke(ctx, Arena_GetHeadToHeadMatrix_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *arenaClient) GetPositionBias(ctx context.Context, in *GetPositionBiasRequest, opts ...grpc.CallOption) (*GetPositionBiasResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPositionBiasResponse)
+	err := c.cc.Invoke(ctx, Arena_GetPositionBias_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *arenaClient) GetTopJokes(ctx context.Context, in *GetTopJokesRequest, opts ...grpc.CallOption) (*GetTopJokesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetTopJokesResponse)
@@ -94,6 +188,9 @@ func (c *arenaClient) GetTopJokes(ctx context.Context, in *GetTopJokesRequest, o
 // for forward compatibility.
 //
 // Arena service provides joke comparison functionalities.
+//
+// Every RPC that ranks, filters or reveals a model identifies it by its public
+// model name, LeaderboardEntry.model.
 type ArenaServer interface {
 	// Retrieves a pair of jokes for comparison.
 	GetChoices(context.Context, *GetChoicesRequest) (*GetChoicesResponse, error)
@@ -101,6 +198,20 @@ type ArenaServer interface {
 	RateChoices(context.Context, *RateChoicesRequest) (*RateChoicesResponse, error)
 	// Gets the leaderboard of joke models.
 	GetLeaderboard(context.Context, *GetLeaderboardRequest) (*GetLeaderboardResponse, error)
+	// Lists stored leaderboard snapshots, newest first.
+	ListLeaderboardSnapshots(context.Context, *ListLeaderboardSnapshotsRequest) (*ListLeaderboardSnapshotsResponse, error)
+	// Gets a leaderboard snapshot by its ID.
+	GetLeaderboardSnapshot(context.Context, *GetLeaderboardSnapshotRequest) (*GetLeaderboardResponse, error)
+	// Compares two leaderboard snapshots.
+	DiffLeaderboardSnapshots(context.Context, *DiffLeaderboardSnapshotsRequest) (*DiffLeaderboardSnapshotsResponse, error)
+	// Gets the score history of a model across leaderboard snapshots.
+	GetModelHistory(context.Context, *GetModelHistoryRequest) (*GetModelHistoryResponse, error)
+	// Gets the votes between two models.
+	GetHeadToHead(context.Context, *GetHeadToHeadRequest) (*GetHeadToHeadResponse, error)
+	// Gets the votes between every pair of models.
+	GetHeadToHeadMatrix(context.Context, *GetHeadToHeadMatrixRequest) (*GetHeadToHeadMatrixResponse, error)
+	// Reports how much the screen side affects votes.
+	GetPositionBias(context.Context, *GetPositionBiasRequest) (*GetPositionBiasResponse, error)
 	// Gets the top jokes.
 	GetTopJokes(context.Context, *GetTopJokesRequest) (*GetTopJokesResponse, error)
 	mustEmbedUnimplementedArenaServer()
@@ -122,6 +233,27 @@ func (UnimplementedArenaServer) RateChoices(context.Context, *RateChoicesRequest
 func (UnimplementedArenaServer) GetLeaderboard(context.Context, *GetLeaderboardRequest) (*GetLeaderboardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLeaderboard not implemented")
 }
+func (UnimplementedArenaServer) ListLeaderboardSnapshots(context.Context, *ListLeaderboardSnapshotsRequest) (*ListLeaderboardSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLeaderboardSnapshots not implemented")
+}
+func (UnimplementedArenaServer) GetLeaderboardSnapshot(context.Context, *GetLeaderboardSnapshotRequest) (*GetLeaderboardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLeaderboardSnapshot not implemented")
+}
+func (UnimplementedArenaServer) DiffLeaderboardSnapshots(context.Context, *DiffLeaderboardSnapshotsRequest) (*DiffLeaderboardSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffLeaderboardSnapshots not implemented")
+}
+func (UnimplementedArenaServer) GetModelHistory(context.Context, *GetModelHistoryRequest) (*GetModelHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetModelHistory not implemented")
+}
+func (UnimplementedArenaServer) GetHeadToHead(context.Context, *GetHeadToHeadRequest) (*GetHeadToHeadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHeadToHead not implemented")
+}
+func (UnimplementedArenaServer) GetHeadToHeadMatrix(context.Context, *GetHeadToHeadMatrixRequest) (*GetHeadToHeadMatrixResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHeadToHeadMatrix not implemented")
+}
+func (UnimplementedArenaServer) GetPositionBias(context.Context, *GetPositionBiasRequest) (*GetPositionBiasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPositionBias not implemented")
+}
 func (UnimplementedArenaServer) GetTopJokes(context.Context, *GetTopJokesRequest) (*GetTopJokesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTopJokes not implemented")
 }
@@ -200,6 +332,132 @@ func _Arena_GetLeaderboard_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Arena_ListLeaderboardSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLeaderboardSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArenaServer).ListLeaderboardSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Arena_ListLeaderboardSnapshots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArenaServer).ListLeaderboardSnapshots(ctx, req.(*ListLeaderboardSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Arena_GetLeaderboardSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeaderboardSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArenaServer).GetLeaderboardSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Arena_GetLeaderboardSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArenaServer).GetLeaderboardSnapshot(ctx, req.(*GetLeaderboardSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Arena_DiffLeaderboardSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffLeaderboardSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArenaServer).DiffLeaderboardSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Arena_DiffLeaderboardSnapshots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArenaServer).DiffLeaderboardSnapshots(ctx, req.(*DiffLeaderboardSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Arena_GetModelHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetModelHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArenaServer).GetModelHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Arena_GetModelHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArenaServer).GetModelHistory(ctx, req.(*GetModelHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Arena_GetHeadToHead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHeadToHeadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArenaServer).GetHeadToHead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Arena_GetHeadToHead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArenaServer).GetHeadToHead(ctx, req.(*GetHeadToHeadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Arena_GetHeadToHeadMatrix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHeadToHeadMatrixRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArenaServer).GetHeadToHeadMatrix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Arena_GetHeadToHeadMatrix_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArenaServer).GetHeadToHeadMatrix(ctx, req.(*GetHeadToHeadMatrixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Arena_GetPositionBias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPositionBiasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArenaServer).GetPositionBias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Arena_GetPositionBias_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArenaServer).GetPositionBias(ctx, req.(*GetPositionBiasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Arena_GetTopJokes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetTopJokesRequest)
 	if err := dec(in); err != nil {
@@ -237,6 +495,34 @@ var Arena_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetLeaderboard",
 			Handler:    _Arena_GetLeaderboard_Handler,
 		},
+		{
+			MethodName: "ListLeaderboardSnapshots",
+			Handler:    _Arena_ListLeaderboardSnapshots_Handler,
+		},
+		{
+			MethodName: "GetLeaderboardSnapshot",
+			Handler:    _Arena_GetLeaderboardSnapshot_Handler,
+		},
+		{
+			MethodName: "DiffLeaderboardSnapshots",
+			Handler:    _Arena_DiffLeaderboardSnapshots_Handler,
+		},
+		{
+			MethodName: "GetModelHistory",
+			Handler:    _Arena_GetModelHistory_Handler,
+		},
+		{
+			MethodName: "GetHeadToHead",
+			Handler:    _Arena_GetHeadToHead_Handler,
+		},
+		{
+			MethodName: "GetHeadToHeadMatrix",
+			Handler:    _Arena_GetHeadToHeadMatrix_Handler,
+		},
+		{
+			MethodName: "GetPositionBias",
+			Handler:    _Arena_GetPositionBias_Handler,
+		},
 		{
 			MethodName: "GetTopJokes",
 			Handler:    _Arena_GetTopJokes_Handler,
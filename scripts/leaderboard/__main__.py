@@ -123,6 +123,17 @@ def bootstrap_confidence_intervals(
     return confidence_intervals
 
 
+def choice_jokes(choice: dict[str, Any], joke_map: dict[str, Any]) -> tuple[Any, Any]:
+    """
+    Returns the left and right jokes of a choice, preferring the snapshots stored in the choice
+    so that votes for deactivated jokes are still counted.
+    """
+    return (
+        choice.get("left_joke") or joke_map.get(choice.get("left_joke_id")),
+        choice.get("right_joke") or joke_map.get(choice.get("right_joke_id")),
+    )
+
+
 def run_once(firestore_client: firestore.Client) -> None:
     choices_ref = firestore_client.collection("choices")
     choices_docs = choices_ref.stream()
@@ -160,10 +171,7 @@ def run_once(firestore_client: firestore.Client) -> None:
     model_votes: defaultdict[str, int] = defaultdict(int)
     for choice in choices:
         found_models: set[str] = set()
-        for joke_id in (choice.get("left_joke_id"), choice.get("right_joke_id")):
-            if joke_id is None:
-                continue
-            joke = joke_map.get(joke_id)
+        for joke in choice_jokes(choice, joke_map):
             if joke is None:
                 continue
             model = joke.get("model")
@@ -200,8 +208,7 @@ def run_once(firestore_client: firestore.Client) -> None:
             skip_count += 1
             continue
 
-        left_joke = joke_map.get(left_joke_id)
-        right_joke = joke_map.get(right_joke_id)
+        left_joke, right_joke = choice_jokes(choice, joke_map)
 
         if left_joke is None or right_joke is None:
             logger.debug("Skipping invalid joke: %s, %s", left_joke_id, right_joke_id)
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/SaveTheRbtz/humor/server/internal/leaderboard"
+	"github.com/SaveTheRbtz/humor/server/internal/storage"
+	"github.com/SaveTheRbtz/humor/server/internal/storage/backend"
+)
+
+func runOnce(
+	ctx context.Context,
+	logger *zap.Logger,
+	store storage.Storage,
+	opts leaderboard.Options,
+	topJokesOpts leaderboard.TopJokesOptions,
+	choiceExpiry time.Duration,
+) error {
+	choices, err := store.ListRatedChoices(ctx)
+	if err != nil {
+		return err
+	}
+	jokes, err := store.ListActiveJokes(ctx)
+	if err != nil {
+		return err
+	}
+	logger.Info("Loaded data", zap.Int("choices", len(choices)), zap.Int("jokes", len(jokes)))
+
+	result, err := leaderboard.Compute(logger, choices, jokes, leaderboard.Filter{}, opts)
+	if err != nil {
+		return err
+	}
+
+	leaderboardID, err := store.AddLeaderboard(ctx, result.Leaderboard)
+	if err != nil {
+		return err
+	}
+	logger.Info("Leaderboard saved successfully", zap.String("id", leaderboardID))
+
+	// Servers that serve choice tokens only store rated choices, so there is
+	// nothing to clean up.
+	if choiceExpiry > 0 {
+		deleted, err := store.DeleteExpiredChoices(ctx, time.Now().Add(-choiceExpiry))
+		if err != nil {
+			return err
+		}
+		logger.Info("Expired choices removed successfully", zap.Int("deleted", deleted))
+	}
+
+	modelWeightsID, err := store.AddModelWeights(ctx, result.ModelWeights)
+	if err != nil {
+		return err
+	}
+	logger.Info("Model weights saved successfully",
+		zap.String("id", modelWeightsID),
+		zap.Strings("models", result.ModelWeights.Models),
+		zap.Float64s("weights", result.ModelWeights.ModelWeights),
+	)
+
+	// Not enough votes per joke should not fail the rest of the job.
+	topJokes, err := leaderboard.TopJokes(logger, choices, jokes, topJokesOpts)
+	if errors.Is(err, leaderboard.ErrNoRatedChoices) {
+		logger.Warn("Top jokes not updated", zap.Error(err))
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	topJokesID, err := store.AddTopJokes(ctx, *topJokes)
+	if err != nil {
+		return err
+	}
+	logger.Info("Top jokes saved successfully", zap.String("id", topJokesID), zap.Int("jokes", len(topJokes.Entries)))
+
+	return nil
+}
+
+func main() {
+	var storageOpts backend.Options
+	storageOpts.RegisterFlags(flag.CommandLine)
+	opts := leaderboard.DefaultOptions()
+	flag.IntVar(&opts.Bootstrap.Samples, "bootstrap", opts.Bootstrap.Samples, "number of bootstrap samples")
+	flag.Float64Var(&opts.Bootstrap.Confidence, "confidence", opts.Bootstrap.Confidence, "confidence level of the intervals")
+	flag.Uint64Var(&opts.Bootstrap.Seed, "seed", opts.Bootstrap.Seed, "bootstrap random seed")
+	flag.IntVar(&opts.Bootstrap.Workers, "workers", 0, "bootstrap goroutines, GOMAXPROCS if zero")
+	flag.Float64Var(&opts.Solver.Tolerance, "newman-tolerance", opts.Solver.Tolerance, "convergence tolerance of the Newman solver")
+	flag.IntVar(&opts.Solver.Limit, "newman-limit", opts.Solver.Limit, "iteration limit of the Newman solver")
+	topJokesOpts := leaderboard.DefaultTopJokesOptions()
+	flag.Int64Var(&topJokesOpts.MinVotes, "top-jokes-min-votes", topJokesOpts.MinVotes, "votes a joke needs to be ranked")
+	flag.IntVar(&topJokesOpts.Limit, "top-jokes-limit", topJokesOpts.Limit, "number of top jokes to store, all if zero")
+	flag.Float64Var(&topJokesOpts.Prior, "top-jokes-prior", topJokesOpts.Prior, "virtual wins and losses of every joke")
+	flag.IntVar(&topJokesOpts.Bootstrap.Samples, "top-jokes-bootstrap", topJokesOpts.Bootstrap.Samples, "number of bootstrap samples for top jokes")
+	choiceExpiry := flag.Duration("choice-expiry", time.Hour, "delete unrated choices older than this, disabled if zero")
+	flag.Parse()
+	topJokesOpts.Bootstrap.Confidence = opts.Bootstrap.Confidence
+	topJokesOpts.Bootstrap.Seed = opts.Bootstrap.Seed
+	topJokesOpts.Bootstrap.Workers = opts.Bootstrap.Workers
+
+	ctx := context.Background()
+
+	zapConfig := zap.NewDevelopmentConfig()
+	zapConfig.DisableStacktrace = true
+	logger, err := zapConfig.Build()
+	if err != nil {
+		log.Fatal("Failed to create logger", zap.Error(err))
+	}
+	defer logger.Sync()
+	logger.Info("Using bootstrap seed", zap.Uint64("seed", opts.Bootstrap.Seed))
+
+	store, err := backend.Open(ctx, storageOpts)
+	if err != nil {
+		logger.Fatal("Failed to open storage", zap.String("storage", storageOpts.Backend), zap.Error(err))
+	}
+	defer store.Close()
+
+	err = runOnce(ctx, logger, store, opts, topJokesOpts, *choiceExpiry)
+	if errors.Is(err, leaderboard.ErrNoRatedChoices) {
+		logger.Warn("Leaderboard not updated", zap.Error(err))
+		return
+	}
+	if err != nil {
+		logger.Fatal("Failed to compute leaderboard", zap.Error(err))
+	}
+	logger.Info("Leaderboard job executed successfully")
+}
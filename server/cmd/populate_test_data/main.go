@@ -2,30 +2,34 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
 	"log"
 	"math/rand"
 	"os"
 	"time"
 
-	"cloud.google.com/go/firestore"
-	serverImpl "github.com/SaveTheRbtz/humor/server/internal/server"
+	"github.com/SaveTheRbtz/humor/server/internal/storage"
+	"github.com/SaveTheRbtz/humor/server/internal/storage/backend"
 )
 
 func main() {
+	var storageOpts backend.Options
+	storageOpts.RegisterFlags(flag.CommandLine)
+	flag.Parse()
+
 	ctx := context.Background()
 
 	// Ensure FIRESTORE_EMULATOR_HOST is set
-	if os.Getenv("FIRESTORE_EMULATOR_HOST") == "" {
+	if storageOpts.Backend == backend.Firestore && os.Getenv("FIRESTORE_EMULATOR_HOST") == "" {
 		log.Fatal("FIRESTORE_EMULATOR_HOST is not set")
 	}
 
-	// Connect to the emulator
-	client, err := firestore.NewClient(ctx, "humor-arena")
+	store, err := backend.Open(ctx, storageOpts)
 	if err != nil {
-		log.Fatalf("Failed to create Firestore client: %v", err)
+		log.Fatalf("Failed to open storage: %v", err)
 	}
-	defer client.Close()
+	defer store.Close()
 
 	rand.Seed(time.Now().UnixNano())
 
@@ -58,17 +62,17 @@ func main() {
 	themeToID := make(map[string]string)
 	for themeName := range themes {
 		rnd := rand.Float64()
-		ref, err := addTheme(ctx, client, themeName, rnd)
+		id, err := addTheme(ctx, store, themeName, rnd)
 		if err != nil {
 			log.Fatalf("Failed to add theme %s: %v", themeName, err)
 		}
-		themeToID[themeName] = ref.ID
+		themeToID[themeName] = id
 		fmt.Printf("Added theme: %f: %s\n", rnd, themeName)
 	}
 
 	for _, joke := range jokes {
 		rnd := rand.Float64()
-		err := addJoke(ctx, client, themeToID[joke.Theme], joke.Theme, joke.Text, rnd)
+		err := addJoke(ctx, store, themeToID[joke.Theme], joke.Theme, joke.Text, rnd)
 		if err != nil {
 			log.Fatalf("Failed to add joke: %v", err)
 		}
@@ -81,27 +85,26 @@ func main() {
 
 func addTheme(
 	ctx context.Context,
-	client *firestore.Client,
+	store storage.Storage,
 	themeName string,
 	rnd float64,
-) (*firestore.DocumentRef, error) {
-	theme := serverImpl.Theme{
+) (string, error) {
+	theme := storage.Theme{
 		Text:   themeName,
 		Random: rnd,
 		Active: true,
 	}
-	docRef, _, err := client.Collection("themes").Add(ctx, theme)
-	return docRef, err
+	return store.AddTheme(ctx, theme)
 }
 
 func addJoke(ctx context.Context,
-	client *firestore.Client,
+	store storage.Storage,
 	themeID string,
 	themeName string,
 	text string,
 	rnd float64,
 ) error {
-	joke := serverImpl.Joke{
+	joke := storage.Joke{
 		ThemeID: themeID,
 		Theme:   themeName,
 		Text:    text,
@@ -109,6 +112,6 @@ func addJoke(ctx context.Context,
 		Random:  rnd,
 		Active:  true,
 	}
-	_, _, err := client.Collection("jokes").Add(ctx, joke)
+	_, err := store.AddJoke(ctx, joke)
 	return err
 }
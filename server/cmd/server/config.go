@@ -0,0 +1,132 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"go.uber.org/zap/zapcore"
+
+	"github.com/SaveTheRbtz/humor/server/internal/sampler"
+	"github.com/SaveTheRbtz/humor/server/internal/storage/backend"
+	"github.com/SaveTheRbtz/humor/server/internal/tracing"
+)
+
+// envPrefix prefixes the environment variable of every flag, e.g.
+// ARENA_CORS_ORIGIN for -cors-origin.
+const envPrefix = "ARENA"
+
+// envAliases maps environment variables set by the hosting platform to
+// flags. Cloud Run sets PORT to the port it sends HTTP requests to.
+var envAliases = map[string]string{
+	"PORT": "port",
+}
+
+// Config is the runtime configuration of the server. Every field is a flag
+// that can also be set through the environment or a configuration file, see
+// config.Load.
+type Config struct {
+	// Port serves both gRPC and HTTP.
+	Port       int
+	StaticPath string
+	// CORSOrigin is the Access-Control-Allow-Origin of API responses.
+	CORSOrigin string
+	LogLevel   zapcore.Level
+
+	Storage backend.Options
+	Tracing tracing.Options
+
+	Sampler          string
+	JokeIndexRefresh time.Duration
+	// CacheTTL is how long the samplers and the server reuse weights,
+	// posteriors, leaderboards and top jokes computed from storage.
+	CacheTTL           time.Duration
+	ChoiceTokenKeyFile string
+	ChoiceExpiry       time.Duration
+	// RequireRankings keeps the server unready until the leaderboard job has
+	// stored a leaderboard and model weights.
+	RequireRankings     bool
+	HealthCheckInterval time.Duration
+	HealthCheckTimeout  time.Duration
+	// ShutdownTimeout bounds how long in-flight requests are drained after
+	// SIGTERM before they are cut off.
+	ShutdownTimeout time.Duration
+}
+
+// RegisterFlags binds the configuration to command line flags.
+func (c *Config) RegisterFlags(fs *flag.FlagSet) {
+	fs.IntVar(&c.Port, "port", 8080, "port of the gRPC and HTTP server, also set by PORT")
+	fs.StringVar(&c.StaticPath, "static-path", "./static", "directory of the web app")
+	fs.StringVar(&c.CORSOrigin, "cors-origin", "*", "allowed origin of cross-origin API requests")
+	c.LogLevel = zapcore.DebugLevel
+	fs.Var(&c.LogLevel, "log-level", "minimum log level: debug, info, warn or error")
+
+	c.Storage.RegisterFlags(fs)
+	c.Tracing.RegisterFlags(fs)
+
+	fs.StringVar(&c.Sampler, "sampler", sampler.ModelWeights, "pair sampler: "+strings.Join(sampler.Names, ", "))
+	fs.DurationVar(&c.JokeIndexRefresh, "joke-index-refresh", 15*time.Minute,
+		"refresh interval of the in-memory joke index, disabled if zero; lookups of missing themes refresh it sooner")
+	fs.DurationVar(&c.CacheTTL, "cache-ttl", time.Minute,
+		"how long sampler weights, leaderboards and top jokes are cached")
+	fs.StringVar(&c.ChoiceTokenKeyFile, "choice-token-key-file", "",
+		"file with the key of encrypted choice tokens; if empty, every choice served is stored")
+	fs.DurationVar(&c.ChoiceExpiry, "choice-expiry", time.Hour, "how long a served choice can be rated, unlimited if zero")
+	fs.BoolVar(&c.RequireRankings, "require-rankings", true,
+		"report not ready until a leaderboard and model weights are stored")
+	fs.DurationVar(&c.HealthCheckInterval, "health-check-interval", 10*time.Second, "interval of the dependency checks")
+	fs.DurationVar(&c.HealthCheckTimeout, "health-check-timeout", 5*time.Second, "timeout of a single dependency check")
+	fs.DurationVar(&c.ShutdownTimeout, "shutdown-timeout", 8*time.Second,
+		"how long in-flight requests are drained on shutdown")
+}
+
+// Validate reports every invalid setting at once.
+func (c *Config) Validate() error {
+	var errs []error
+	if c.Port < 1 || c.Port > 65535 {
+		errs = append(errs, fmt.Errorf("port must be within [1, 65535]: %d", c.Port))
+	}
+	if c.StaticPath == "" {
+		errs = append(errs, errors.New("static path is required"))
+	}
+	if c.CORSOrigin == "" {
+		errs = append(errs, errors.New("CORS origin is required"))
+	}
+	for _, d := range []struct {
+		name  string
+		value time.Duration
+	}{
+		{"health check interval", c.HealthCheckInterval},
+		{"health check timeout", c.HealthCheckTimeout},
+		{"shutdown timeout", c.ShutdownTimeout},
+	} {
+		if d.value <= 0 {
+			errs = append(errs, fmt.Errorf("%s must be positive: %s", d.name, d.value))
+		}
+	}
+	if !slices.Contains(sampler.Names, c.Sampler) {
+		errs = append(errs, fmt.Errorf("unknown sampler: %q", c.Sampler))
+	}
+	for _, d := range []struct {
+		name  string
+		value time.Duration
+	}{
+		{"joke index refresh", c.JokeIndexRefresh},
+		{"cache TTL", c.CacheTTL},
+		{"choice expiry", c.ChoiceExpiry},
+	} {
+		if d.value < 0 {
+			errs = append(errs, fmt.Errorf("%s must not be negative: %s", d.name, d.value))
+		}
+	}
+	if err := c.Storage.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	if err := c.Tracing.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
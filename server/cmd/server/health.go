@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/SaveTheRbtz/humor/server/internal/health"
+	"github.com/SaveTheRbtz/humor/server/internal/storage"
+)
+
+// healthChecks returns the dependencies of the Arena service. The rankings
+// are produced by the leaderboard job; if requireRankings is false, their
+// absence does not make the server unready, but storage errors still do.
+func healthChecks(store storage.Storage, requireRankings bool) []health.Check {
+	latest := func(get func(context.Context) error) func(context.Context) error {
+		return func(ctx context.Context) error {
+			err := get(ctx)
+			if errors.Is(err, storage.ErrNotFound) && !requireRankings {
+				return nil
+			}
+			return err
+		}
+	}
+	return []health.Check{
+		{
+			Name: "model_weights",
+			Run: latest(func(ctx context.Context) error {
+				_, err := store.GetLatestModelWeights(ctx)
+				return err
+			}),
+		},
+		{
+			Name: "leaderboard",
+			Run: latest(func(ctx context.Context) error {
+				_, err := store.GetLatestLeaderboard(ctx)
+				return err
+			}),
+		},
+		{
+			// Looks for a theme with two active jokes to pair without going
+			// through the sampler, so checks do not show up in its metrics.
+			// With the joke index both lookups are served from memory.
+			Name: "pairs",
+			Run: func(ctx context.Context) error {
+				themes, err := store.ListActiveThemes(ctx)
+				if err != nil {
+					return fmt.Errorf("failed to list themes: %w", err)
+				}
+				for _, theme := range themes {
+					jokes, err := store.GetActiveJokesByTheme(ctx, theme.Text)
+					if err != nil {
+						return fmt.Errorf("failed to get jokes: %w", err)
+					}
+					if len(jokes) >= 2 {
+						return nil
+					}
+				}
+				return fmt.Errorf("none of %d active themes has two active jokes", len(themes))
+			},
+		},
+	}
+}
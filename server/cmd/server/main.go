@@ -1,17 +1,37 @@
 package main
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"flag"
+	"fmt"
 	"log"
 	"net"
 	"net/http"
 	"os"
+	"os/signal"
 	"path/filepath"
+	"syscall"
+	"time"
 
-	"cloud.google.com/go/firestore"
 	choicesv1 "github.com/SaveTheRbtz/humor/gen/go/proto"
+	"github.com/SaveTheRbtz/humor/server/internal/choicetoken"
+	"github.com/SaveTheRbtz/humor/server/internal/config"
+	"github.com/SaveTheRbtz/humor/server/internal/health"
+	"github.com/SaveTheRbtz/humor/server/internal/metrics"
+	"github.com/SaveTheRbtz/humor/server/internal/sampler"
 	serverImpl "github.com/SaveTheRbtz/humor/server/internal/server"
+	"github.com/SaveTheRbtz/humor/server/internal/storage"
+	"github.com/SaveTheRbtz/humor/server/internal/storage/backend"
+	"github.com/SaveTheRbtz/humor/server/internal/storage/instrumented"
+	"github.com/SaveTheRbtz/humor/server/internal/storage/jokeindex"
+	"github.com/SaveTheRbtz/humor/server/internal/tracing"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"go.uber.org/zap"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
 	healthgrpc "google.golang.org/grpc/health"
 	"google.golang.org/grpc/health/grpc_health_v1"
 
@@ -21,9 +41,9 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 )
 
-func allowCORS(h http.Handler) http.Handler {
+func allowCORS(h http.Handler, origin string) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.Header().Set("Access-Control-Allow-Origin", origin)
 		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
 		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
 
@@ -34,6 +54,13 @@ func allowCORS(h http.Handler) http.Handler {
 	})
 }
 
+// untracedPaths are polled by monitoring and would only add noise to traces.
+var untracedPaths = map[string]bool{
+	"/metrics": true,
+	"/healthz": true,
+	"/readyz":  true,
+}
+
 func spaHandler(staticPath string, indexPath string) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		path := filepath.Join(staticPath, r.URL.Path)
@@ -50,64 +77,186 @@ func spaHandler(staticPath string, indexPath string) http.HandlerFunc {
 }
 
 func main() {
+	var cfg Config
+	cfg.RegisterFlags(flag.CommandLine)
+	if err := config.Load(flag.CommandLine, os.Args[1:], envPrefix, envAliases); err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		log.Fatalf("Invalid config: %v", err)
+	}
+
 	ctx := context.Background()
+	// Cloud Run sends SIGTERM and kills the container once its grace period
+	// ends.
+	signalCtx, stopSignals := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stopSignals()
 
 	zapConfig := zap.NewDevelopmentConfig()
 	zapConfig.DisableStacktrace = true
+	zapConfig.Level = zap.NewAtomicLevelAt(cfg.LogLevel)
 	logger, err := zapConfig.Build()
 	if err != nil {
 		log.Fatal("Failed to create logger", zap.Error(err))
 	}
 	defer logger.Sync()
+	logger.Debug("Loaded config", zap.Any("config", config.Values(flag.CommandLine)))
 
-	firestoreClient, err := firestore.NewClient(ctx, "humor-arena")
+	shutdownTracing, err := tracing.Setup(ctx, cfg.Tracing)
 	if err != nil {
-		logger.Fatal("Failed to create Firestore client", zap.Error(err))
+		logger.Fatal("Failed to set up tracing", zap.Error(err))
 	}
-	defer firestoreClient.Close()
 
-	go func() {
-		lis, err := net.Listen("tcp", ":9090")
+	var store storage.Storage
+	store, err = backend.Open(ctx, cfg.Storage)
+	if err != nil {
+		logger.Fatal("Failed to open storage", zap.String("storage", cfg.Storage.Backend), zap.Error(err))
+	}
+	store = instrumented.New(store, cfg.Storage.Backend)
+	if cfg.JokeIndexRefresh > 0 {
+		store, err = jokeindex.New(ctx, store, logger, jokeindex.Options{
+			Interval:            cfg.JokeIndexRefresh,
+			StorageRandomThemes: cfg.Storage.SamplesThemes(),
+		})
 		if err != nil {
-			logger.Fatal("Failed to listen", zap.Error(err))
+			logger.Fatal("Failed to load joke index", zap.Error(err))
 		}
-		grpcServer := grpc.NewServer()
+	}
 
-		healthServer := healthgrpc.NewServer()
-		healthServer.SetServingStatus("grpc.health.v1.Health", grpc_health_v1.HealthCheckResponse_SERVING)
-		grpc_health_v1.RegisterHealthServer(grpcServer, healthServer)
+	pairSampler, err := sampler.New(cfg.Sampler, store, logger, cfg.CacheTTL)
+	if err != nil {
+		logger.Fatal("Failed to create sampler", zap.Error(err))
+	}
+	logger.Info("Using sampler", zap.String("sampler", pairSampler.Name()))
 
-		choicesServer, err := serverImpl.NewServer(
-			firestoreClient,
-			logger,
-		)
+	var tokens *choicetoken.Sealer
+	if cfg.ChoiceTokenKeyFile != "" {
+		key, err := os.ReadFile(cfg.ChoiceTokenKeyFile)
 		if err != nil {
-			logger.Fatal("Failed to create server", zap.Error(err))
+			logger.Fatal("Failed to read choice token key", zap.Error(err))
 		}
-		defer choicesServer.Close()
-
-		choicesv1.RegisterArenaServer(grpcServer, choicesServer)
-
-		reflection.Register(grpcServer)
-		logger.Info("Serving gRPC on localhost:9090")
-		if err := grpcServer.Serve(lis); err != nil {
-			logger.Fatal("Failed to serve gRPC", zap.Error(err))
+		tokens, err = choicetoken.NewSealer(bytes.TrimSpace(key))
+		if err != nil {
+			logger.Fatal("Failed to create choice token sealer", zap.Error(err))
 		}
-	}()
+		logger.Info("Using encrypted choice tokens")
+	}
 
-	grpcMux := runtime.NewServeMux()
-	opts := []grpc.DialOption{grpc.WithInsecure()}
-	err = choicesv1.RegisterArenaHandlerFromEndpoint(ctx, grpcMux, "localhost:9090", opts)
+	// The choices server owns the store from here on and closes it last.
+	choicesServer, err := serverImpl.NewServer(
+		store,
+		pairSampler,
+		logger,
+		serverImpl.Options{
+			ChoiceTokens: tokens,
+			ChoiceExpiry: cfg.ChoiceExpiry,
+			CacheTTL:     cfg.CacheTTL,
+		},
+	)
 	if err != nil {
+		logger.Fatal("Failed to create server", zap.Error(err))
+	}
+
+	grpcServer := grpc.NewServer(
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(metrics.UnaryServerInterceptor),
+	)
+	healthServer := healthgrpc.NewServer()
+	grpc_health_v1.RegisterHealthServer(grpcServer, healthServer)
+	healthChecker := health.NewChecker(
+		healthServer,
+		healthChecks(store, cfg.RequireRankings),
+		logger,
+		health.Options{
+			Services: []string{choicesv1.Arena_ServiceDesc.ServiceName},
+			Interval: cfg.HealthCheckInterval,
+			Timeout:  cfg.HealthCheckTimeout,
+		},
+	)
+	choicesv1.RegisterArenaServer(grpcServer, choicesServer)
+	reflection.Register(grpcServer)
+
+	// The gateway calls the server in-process, so gRPC interceptors do not
+	// run for REST requests; the gateway middlewares record them instead.
+	gatewayMux := runtime.NewServeMux(runtime.WithMiddlewares(metrics.GatewayMiddleware, tracing.GatewayMiddleware))
+	if err := choicesv1.RegisterArenaHandlerServer(ctx, gatewayMux, choicesServer); err != nil {
 		logger.Fatal("Failed to register gRPC gateway", zap.Error(err))
 	}
-	logger.Info("Serving HTTP on localhost:8080")
 
 	mainMux := http.NewServeMux()
-	mainMux.Handle("/v1/", allowCORS(grpcMux))
-	mainMux.HandleFunc("/", spaHandler("./static", "index.html"))
+	mainMux.Handle("/v1/", allowCORS(gatewayMux, cfg.CORSOrigin))
+	mainMux.Handle("/metrics", metrics.Handler())
+	mainMux.Handle("/healthz", health.LivenessHandler())
+	mainMux.Handle("/readyz", healthChecker.ReadinessHandler())
+	mainMux.Handle("/", metrics.Middleware("static", spaHandler(cfg.StaticPath, "index.html")))
 
-	if err := http.ListenAndServe(":8080", mainMux); err != nil {
-		logger.Fatal("Failed to serve HTTP", zap.Error(err))
+	// gRPC requests bypass otelhttp, the gRPC server traces them itself.
+	mux := newProtocolMux(grpcServer, otelhttp.NewHandler(mainMux, "http",
+		otelhttp.WithFilter(func(r *http.Request) bool { return !untracedPaths[r.URL.Path] }),
+	))
+	http2Server := &http2.Server{}
+	httpServer := &http.Server{
+		Handler: h2c.NewHandler(mux, http2Server),
+	}
+	// Lets Shutdown send GOAWAY on h2c connections.
+	if err := http2.ConfigureServer(httpServer, http2Server); err != nil {
+		logger.Fatal("Failed to configure HTTP/2", zap.Error(err))
+	}
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.Port))
+	if err != nil {
+		logger.Fatal("Failed to listen", zap.Error(err))
+	}
+
+	healthChecker.Start(ctx)
+
+	serveErr := make(chan error, 1)
+	go func() {
+		logger.Info("Serving gRPC and HTTP", zap.Int("port", cfg.Port))
+		if err := httpServer.Serve(listener); !errors.Is(err, http.ErrServerClosed) {
+			serveErr <- fmt.Errorf("failed to serve: %w", err)
+		}
+	}()
+
+	var failure error
+	select {
+	case <-signalCtx.Done():
+		logger.Info("Shutting down", zap.Duration("timeout", cfg.ShutdownTimeout))
+	case failure = <-serveErr:
+		logger.Error("Shutting down after the server failed", zap.Error(failure))
+	}
+	// A second signal kills the process right away.
+	stopSignals()
+
+	shutdownCtx, cancel := context.WithTimeout(ctx, cfg.ShutdownTimeout)
+	defer cancel()
+
+	// Load balancers and probes stop routing new requests first.
+	healthChecker.Stop()
+
+	if err := httpServer.Shutdown(shutdownCtx); err != nil {
+		logger.Warn("HTTP requests did not drain in time", zap.Error(err))
+		httpServer.Close()
+	}
+	if err := mux.drainRPCs(shutdownCtx); err != nil {
+		logger.Warn("gRPC requests did not drain in time", zap.Error(err))
+	}
+	// GracefulStop does not support requests served through ServeHTTP, and
+	// there are none left to wait for anyway.
+	grpcServer.Stop()
+
+	// Spans still get flushed after the deadline, but only briefly.
+	flushCtx, cancelFlush := context.WithTimeout(ctx, time.Second)
+	defer cancelFlush()
+	if err := shutdownTracing(flushCtx); err != nil {
+		logger.Warn("Failed to flush traces", zap.Error(err))
+	}
+
+	// No request is running anymore, so every vote has been written.
+	if err := choicesServer.Close(); err != nil {
+		logger.Error("Failed to close storage", zap.Error(err))
+	}
+	if failure != nil {
+		logger.Fatal("Server failed", zap.Error(failure))
 	}
+	logger.Info("Shut down")
 }
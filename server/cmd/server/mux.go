@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// protocolMux serves native gRPC and plain HTTP on one listener. gRPC
+// requests arrive over HTTP/2, usually h2c since Cloud Run and most proxies
+// terminate TLS, and are told apart by their content type.
+type protocolMux struct {
+	grpcServer *grpc.Server
+	http       http.Handler
+	// activeRPCs counts the gRPC requests being served. http.Server.Shutdown
+	// does not wait for them, since h2c connections are hijacked.
+	activeRPCs atomic.Int64
+}
+
+func newProtocolMux(grpcServer *grpc.Server, httpHandler http.Handler) *protocolMux {
+	return &protocolMux{grpcServer: grpcServer, http: httpHandler}
+}
+
+func isGRPC(r *http.Request) bool {
+	return r.ProtoMajor == 2 && strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc")
+}
+
+func (m *protocolMux) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !isGRPC(r) {
+		m.http.ServeHTTP(w, r)
+		return
+	}
+	m.activeRPCs.Add(1)
+	defer m.activeRPCs.Add(-1)
+	m.grpcServer.ServeHTTP(w, r)
+}
+
+// drainPollInterval matches the polling of http.Server.Shutdown.
+const drainPollInterval = 100 * time.Millisecond
+
+// drainRPCs waits until no gRPC request is being served. It is called after
+// http.Server.Shutdown, which asks HTTP/2 clients to stop sending new
+// requests.
+func (m *protocolMux) drainRPCs(ctx context.Context) error {
+	ticker := time.NewTicker(drainPollInterval)
+	defer ticker.Stop()
+	for m.activeRPCs.Load() > 0 {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+	return nil
+}
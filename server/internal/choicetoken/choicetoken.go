@@ -0,0 +1,162 @@
+// Package choicetoken encrypts unrated choices into tokens so the server does
+// not have to store a choice until it is rated. Tokens are sealed with
+// AES-256-GCM, so clients can neither forge them nor read the models,
+// sampler or side swap before voting.
+package choicetoken
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/SaveTheRbtz/humor/server/internal/storage"
+)
+
+// MinKeySize is the minimum length of the configured key in bytes.
+const MinKeySize = 32
+
+// marker starts every token and tells it apart from the ID of a stored
+// choice, which never contains a dot.
+const marker = "ct."
+
+// keyLabel derives the encryption key from the configured key, which may be
+// longer than the 32 bytes AES-256 takes.
+const keyLabel = "choice token encryption"
+
+// ErrInvalidToken is returned for malformed tokens and tokens that fail
+// authentication.
+var ErrInvalidToken = errors.New("invalid choice token")
+
+// payload is the encrypted part of a token. Field names are short to keep the
+// token compact.
+type payload struct {
+	ID          string `json:"i"`
+	ThemeID     string `json:"t"`
+	SessionID   string `json:"s,omitempty"`
+	LeftJokeID  string `json:"l"`
+	RightJokeID string `json:"r"`
+	CreatedAt   int64  `json:"c"`
+	Sampler     string `json:"m,omitempty"`
+	Swapped     *bool  `json:"w,omitempty"`
+	Theme       string `json:"h,omitempty"`
+	LeftJoke    *joke  `json:"lj,omitempty"`
+	RightJoke   *joke  `json:"rj,omitempty"`
+}
+
+// joke is the encrypted storage.ChoiceJoke.
+type joke struct {
+	Model    string `json:"m"`
+	Policy   string `json:"p,omitempty"`
+	ThemeSet string `json:"s,omitempty"`
+}
+
+func newJoke(j *storage.ChoiceJoke) *joke {
+	if j == nil {
+		return nil
+	}
+	return &joke{Model: j.Model, Policy: j.Policy, ThemeSet: j.ThemeSet}
+}
+
+func (j *joke) choiceJoke() *storage.ChoiceJoke {
+	if j == nil {
+		return nil
+	}
+	return &storage.ChoiceJoke{Model: j.Model, Policy: j.Policy, ThemeSet: j.ThemeSet}
+}
+
+// Sealer encrypts and decrypts choice tokens.
+type Sealer struct {
+	aead cipher.AEAD
+}
+
+// NewSealer creates a sealer with the given secret key.
+func NewSealer(key []byte) (*Sealer, error) {
+	if len(key) < MinKeySize {
+		return nil, fmt.Errorf("choice token key is too short: %d < %d bytes", len(key), MinKeySize)
+	}
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(keyLabel))
+	block, err := aes.NewCipher(h.Sum(nil))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create choice token cipher: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create choice token cipher: %w", err)
+	}
+	return &Sealer{aead: aead}, nil
+}
+
+// IsToken reports whether id looks like a choice token rather than the ID of
+// a stored choice.
+func IsToken(id string) bool {
+	return strings.HasPrefix(id, marker)
+}
+
+// Seal encrypts the unrated choice and its ID into a token.
+func (s *Sealer) Seal(id string, choice storage.Choice) (string, error) {
+	data, err := json.Marshal(payload{
+		ID:          id,
+		ThemeID:     choice.ThemeID,
+		SessionID:   choice.SessionID,
+		LeftJokeID:  choice.LeftJokeID,
+		RightJokeID: choice.RightJokeID,
+		CreatedAt:   choice.CreatedAt.UnixNano(),
+		Sampler:     choice.Sampler,
+		Swapped:     choice.Swapped,
+		Theme:       choice.Theme,
+		LeftJoke:    newJoke(choice.LeftJoke),
+		RightJoke:   newJoke(choice.RightJoke),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode choice token: %w", err)
+	}
+	nonce := make([]byte, s.aead.NonceSize(), s.aead.NonceSize()+len(data)+s.aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("failed to generate choice token nonce: %w", err)
+	}
+	sealed := s.aead.Seal(nonce, nonce, data, nil)
+	return marker + base64.RawURLEncoding.EncodeToString(sealed), nil
+}
+
+// Open authenticates and decrypts the token and returns the unrated choice it
+// encodes.
+func (s *Sealer) Open(token string) (*storage.Choice, error) {
+	if !IsToken(token) {
+		return nil, ErrInvalidToken
+	}
+	sealed, err := base64.RawURLEncoding.DecodeString(strings.TrimPrefix(token, marker))
+	if err != nil || len(sealed) < s.aead.NonceSize() {
+		return nil, ErrInvalidToken
+	}
+	nonce, ciphertext := sealed[:s.aead.NonceSize()], sealed[s.aead.NonceSize():]
+	data, err := s.aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	var p payload
+	if err := json.Unmarshal(data, &p); err != nil || p.ID == "" {
+		return nil, ErrInvalidToken
+	}
+	return &storage.Choice{
+		ID:          p.ID,
+		ThemeID:     p.ThemeID,
+		SessionID:   p.SessionID,
+		LeftJokeID:  p.LeftJokeID,
+		RightJokeID: p.RightJokeID,
+		CreatedAt:   time.Unix(0, p.CreatedAt),
+		Sampler:     p.Sampler,
+		Swapped:     p.Swapped,
+		Theme:       p.Theme,
+		LeftJoke:    p.LeftJoke.choiceJoke(),
+		RightJoke:   p.RightJoke.choiceJoke(),
+	}, nil
+}
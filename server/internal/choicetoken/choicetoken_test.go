@@ -0,0 +1,121 @@
+package choicetoken
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/SaveTheRbtz/humor/server/internal/storage"
+)
+
+func newSealer(t *testing.T, key string) *Sealer {
+	t.Helper()
+	s, err := NewSealer([]byte(key))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func testChoice() storage.Choice {
+	swapped := true
+	return storage.Choice{
+		ThemeID:     "theme",
+		SessionID:   "session",
+		LeftJokeID:  "left",
+		RightJokeID: "right",
+		CreatedAt:   time.Unix(0, 1234567890),
+		Sampler:     "information-gain",
+		Swapped:     &swapped,
+		Theme:       "Cats",
+		LeftJoke:    &storage.ChoiceJoke{Model: "model-a", Policy: "policy-a", ThemeSet: "set-a"},
+		RightJoke:   &storage.ChoiceJoke{Model: "model-b"},
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	s := newSealer(t, strings.Repeat("k", MinKeySize))
+	choice := testChoice()
+	token, err := s.Seal("id", choice)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !IsToken(token) {
+		t.Fatalf("IsToken(%q) = false", token)
+	}
+	got, err := s.Open(token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.ID != "id" || got.ThemeID != choice.ThemeID || got.SessionID != choice.SessionID ||
+		got.LeftJokeID != choice.LeftJokeID || got.RightJokeID != choice.RightJokeID ||
+		!got.CreatedAt.Equal(choice.CreatedAt) || got.Sampler != choice.Sampler ||
+		got.Swapped == nil || !*got.Swapped || got.Theme != choice.Theme ||
+		*got.LeftJoke != *choice.LeftJoke || *got.RightJoke != *choice.RightJoke {
+		t.Errorf("Open = %+v, want %+v", got, choice)
+	}
+
+	// Every token uses a fresh nonce.
+	again, err := s.Seal("id", choice)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again == token {
+		t.Error("sealing the same choice twice gave the same token")
+	}
+}
+
+func TestTokenHidesChoice(t *testing.T) {
+	s := newSealer(t, strings.Repeat("k", MinKeySize))
+	token, err := s.Seal("id", testChoice())
+	if err != nil {
+		t.Fatal(err)
+	}
+	sealed, err := base64.RawURLEncoding.DecodeString(strings.TrimPrefix(token, marker))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"model-a", "model-b", "policy-a", "information-gain", "Cats", `"w"`} {
+		if strings.Contains(token, secret) || bytes.Contains(sealed, []byte(secret)) {
+			t.Errorf("token reveals %q", secret)
+		}
+	}
+}
+
+func TestOpenRejects(t *testing.T) {
+	s := newSealer(t, strings.Repeat("k", MinKeySize))
+	token, err := s.Seal("id", testChoice())
+	if err != nil {
+		t.Fatal(err)
+	}
+	sealed, err := base64.RawURLEncoding.DecodeString(strings.TrimPrefix(token, marker))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sealed[len(sealed)-1] ^= 1
+
+	for name, token := range map[string]string{
+		"tampered":   marker + base64.RawURLEncoding.EncodeToString(sealed),
+		"truncated":  token[:len(marker)+8],
+		"empty":      marker,
+		"not base64": marker + "!!!",
+	} {
+		if _, err := s.Open(token); !errors.Is(err, ErrInvalidToken) {
+			t.Errorf("%s: Open = %v, want ErrInvalidToken", name, err)
+		}
+	}
+
+	other := newSealer(t, strings.Repeat("o", MinKeySize))
+	if _, err := other.Open(token); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("Open with another key = %v, want ErrInvalidToken", err)
+	}
+}
+
+func TestShortKey(t *testing.T) {
+	if _, err := NewSealer([]byte(strings.Repeat("k", MinKeySize-1))); err == nil {
+		t.Error("NewSealer accepted a short key")
+	}
+}
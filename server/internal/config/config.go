@@ -0,0 +1,123 @@
+// Package config layers a configuration file and environment variables
+// under the command line flags of a binary, so every setting has a single
+// definition: its flag.
+package config
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// FileFlag is the flag that names the optional configuration file.
+const FileFlag = "config"
+
+// Load parses args into fs and fills the flags that were not set on the
+// command line. Values are taken, in order of precedence, from the command
+// line, from environment variables named by EnvName, from aliases, and from
+// the YAML or JSON file named by the FileFlag flag, which holds a flat map
+// of flag names to values. aliases maps extra environment variables, such as
+// PORT, to flag names. Flags keep their defaults otherwise.
+func Load(fs *flag.FlagSet, args []string, envPrefix string, aliases map[string]string) error {
+	var file string
+	if fs.Lookup(FileFlag) == nil {
+		fs.StringVar(&file, FileFlag, "", "optional YAML or JSON configuration file")
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if f := fs.Lookup(FileFlag); f != nil {
+		file = f.Value.String()
+	}
+
+	explicit := make(map[string]bool)
+	fs.Visit(func(f *flag.Flag) {
+		explicit[f.Name] = true
+	})
+	set := func(name, value, source string) error {
+		if explicit[name] {
+			return nil
+		}
+		if err := fs.Set(name, value); err != nil {
+			return fmt.Errorf("invalid %s from %s: %w", name, source, err)
+		}
+		return nil
+	}
+
+	if file != "" {
+		values, err := readFile(file)
+		if err != nil {
+			return err
+		}
+		for name, value := range values {
+			if name == FileFlag || fs.Lookup(name) == nil {
+				return fmt.Errorf("unknown setting in %s: %s", file, name)
+			}
+			if err := set(name, value, file); err != nil {
+				return err
+			}
+		}
+	}
+
+	for env, name := range aliases {
+		if value, ok := os.LookupEnv(env); ok {
+			if err := set(name, value, env); err != nil {
+				return err
+			}
+		}
+	}
+
+	var err error
+	fs.VisitAll(func(f *flag.Flag) {
+		if err != nil || f.Name == FileFlag {
+			return
+		}
+		env := EnvName(envPrefix, f.Name)
+		if value, ok := os.LookupEnv(env); ok {
+			err = set(f.Name, value, env)
+		}
+	})
+	return err
+}
+
+// Values returns the resolved value of every flag by name, for logging.
+func Values(fs *flag.FlagSet) map[string]string {
+	values := make(map[string]string)
+	fs.VisitAll(func(f *flag.Flag) {
+		values[f.Name] = f.Value.String()
+	})
+	return values
+}
+
+// EnvName returns the environment variable of a flag, e.g. ARENA_CORS_ORIGIN
+// for the cors-origin flag with the ARENA prefix.
+func EnvName(prefix, flagName string) string {
+	return prefix + "_" + strings.ToUpper(strings.ReplaceAll(flagName, "-", "_"))
+}
+
+func readFile(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+	// JSON is a subset of YAML.
+	var raw map[string]any
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+	values := make(map[string]string, len(raw))
+	for name, value := range raw {
+		switch value.(type) {
+		case map[string]any, []any:
+			return nil, fmt.Errorf("setting %s in %s must be a scalar", name, path)
+		case nil:
+			values[name] = ""
+		default:
+			values[name] = fmt.Sprint(value)
+		}
+	}
+	return values, nil
+}
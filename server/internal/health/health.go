@@ -0,0 +1,208 @@
+// Package health drives the gRPC health statuses and the HTTP probes of the
+// arena server from periodic dependency checks.
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+	healthgrpc "google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/SaveTheRbtz/humor/server/internal/metrics"
+)
+
+// Check is a dependency the server needs to serve requests.
+type Check struct {
+	Name string
+	// Run returns an error if the dependency is unavailable. It may also warm
+	// up caches so that the first requests after a successful check are fast.
+	Run func(ctx context.Context) error
+}
+
+// CheckResult is the outcome of the last run of a check.
+type CheckResult struct {
+	Name  string `json:"name"`
+	OK    bool   `json:"ok"`
+	Error string `json:"error,omitempty"`
+}
+
+// Report is the readiness of the server and the checks it is based on.
+type Report struct {
+	Ready     bool          `json:"ready"`
+	CheckedAt time.Time     `json:"checkedAt"`
+	Checks    []CheckResult `json:"checks"`
+}
+
+// Options configures a Checker.
+type Options struct {
+	// Services are the gRPC services that are SERVING only while every check
+	// passes. The overall "" service always follows them.
+	Services []string
+	// Interval is the time between two rounds of checks.
+	Interval time.Duration
+	// Timeout bounds a single check.
+	Timeout time.Duration
+}
+
+// Checker periodically runs the checks and publishes the result to a gRPC
+// health server. Until the first round completes the server is not ready.
+//
+// The health service itself reports liveness: it is SERVING as long as the
+// process runs, whatever the checks return.
+type Checker struct {
+	server *healthgrpc.Server
+	checks []Check
+	opts   Options
+	logger *zap.Logger
+
+	report atomic.Pointer[Report]
+	// stopped makes the server unready for good once shutdown begins.
+	stopped atomic.Bool
+
+	cancel context.CancelFunc
+	done   sync.WaitGroup
+}
+
+// NewChecker creates a checker that publishes to server. The services start
+// NOT_SERVING.
+func NewChecker(server *healthgrpc.Server, checks []Check, logger *zap.Logger, opts Options) *Checker {
+	c := &Checker{
+		server: server,
+		checks: checks,
+		opts:   opts,
+		logger: logger,
+		cancel: func() {},
+	}
+	server.SetServingStatus(grpc_health_v1.Health_ServiceDesc.ServiceName, grpc_health_v1.HealthCheckResponse_SERVING)
+	c.setServing(false)
+	return c
+}
+
+// Start runs the checks right away and then every interval until Stop.
+func (c *Checker) Start(ctx context.Context) {
+	ctx, c.cancel = context.WithCancel(ctx)
+	c.done.Add(1)
+	go func() {
+		defer c.done.Done()
+
+		ticker := time.NewTicker(c.opts.Interval)
+		defer ticker.Stop()
+		for {
+			c.runChecks(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop stops the checks and marks every service NOT_SERVING, including the
+// health service, so load balancers drain the instance.
+func (c *Checker) Stop() {
+	c.stopped.Store(true)
+	c.cancel()
+	c.done.Wait()
+	c.server.Shutdown()
+}
+
+// runChecks runs every check once and publishes the result.
+func (c *Checker) runChecks(ctx context.Context) {
+	report := &Report{
+		Ready:     true,
+		CheckedAt: time.Now(),
+		Checks:    make([]CheckResult, len(c.checks)),
+	}
+	for i, check := range c.checks {
+		checkCtx, cancel := context.WithTimeout(ctx, c.opts.Timeout)
+		err := check.Run(checkCtx)
+		cancel()
+		report.Checks[i] = CheckResult{Name: check.Name, OK: err == nil}
+		if err != nil {
+			report.Ready = false
+			report.Checks[i].Error = err.Error()
+		}
+	}
+	if ctx.Err() != nil {
+		// Checks cut short by Stop say nothing about the dependencies.
+		return
+	}
+
+	for _, result := range report.Checks {
+		metrics.DependencyUp.WithLabelValues(result.Name).Set(boolToFloat(result.OK))
+	}
+	if previous := c.report.Swap(report); previous == nil || previous.Ready != report.Ready {
+		if report.Ready {
+			c.logger.Info("Server is ready")
+		} else {
+			c.logger.Warn("Server is not ready", zap.Any("checks", report.Checks))
+		}
+	}
+	c.setServing(report.Ready)
+}
+
+func (c *Checker) setServing(serving bool) {
+	status := grpc_health_v1.HealthCheckResponse_NOT_SERVING
+	if serving {
+		status = grpc_health_v1.HealthCheckResponse_SERVING
+	}
+	// Updates after Shutdown are ignored by the health server.
+	c.server.SetServingStatus("", status)
+	for _, service := range c.opts.Services {
+		c.server.SetServingStatus(service, status)
+	}
+}
+
+// Ready reports whether the last round of checks passed and shutdown has not
+// begun.
+func (c *Checker) Ready() bool {
+	report := c.report.Load()
+	return report != nil && report.Ready && !c.stopped.Load()
+}
+
+// LivenessHandler serves /healthz. It succeeds whenever the process can
+// answer, since restarting it does not fix an unavailable dependency.
+func LivenessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Cache-Control", "no-store")
+		fmt.Fprintln(w, "ok")
+	})
+}
+
+// ReadinessHandler serves /readyz with the last report as JSON, with status
+// 200 if the server is ready and 503 otherwise.
+func (c *Checker) ReadinessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		report := c.report.Load()
+		if report == nil {
+			report = &Report{Checks: []CheckResult{}}
+		}
+		ready := c.Ready()
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "no-store")
+		if !ready {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		json.NewEncoder(w).Encode(Report{
+			Ready:     ready,
+			CheckedAt: report.CheckedAt,
+			Checks:    report.Checks,
+		})
+	})
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,200 @@
+package leaderboard
+
+import (
+	"sort"
+	"time"
+
+	choicesv1 "github.com/SaveTheRbtz/humor/gen/go/proto"
+	"github.com/SaveTheRbtz/humor/server/internal/storage"
+)
+
+// Record counts votes between two models from the first model's side.
+type Record struct {
+	Wins    int64
+	Losses  int64
+	Ties    int64
+	BothBad int64
+}
+
+// Votes returns the total number of votes, including both-bad ones.
+func (r Record) Votes() int64 {
+	return r.Wins + r.Losses + r.Ties + r.BothBad
+}
+
+// WinRate counts ties as half a win and, like the ratings, ignores both-bad
+// votes. It is zero when there are no other votes.
+func (r Record) WinRate() float64 {
+	n := r.Wins + r.Losses + r.Ties
+	if n == 0 {
+		return 0
+	}
+	return (float64(r.Wins) + float64(r.Ties)/2) / float64(n)
+}
+
+func (r Record) swap() Record {
+	r.Wins, r.Losses = r.Losses, r.Wins
+	return r
+}
+
+func (r *Record) add(winner choicesv1.Winner) {
+	switch winner {
+	case choicesv1.Winner_LEFT:
+		r.Wins++
+	case choicesv1.Winner_RIGHT:
+		r.Losses++
+	case choicesv1.Winner_BOTH:
+		r.Ties++
+	case choicesv1.Winner_NONE:
+		r.BothBad++
+	}
+}
+
+// ThemeRecord is the part of a Record coming from votes on one theme.
+type ThemeRecord struct {
+	ThemeID string
+	Theme   string
+	Record
+}
+
+// Example is a rated joke pair. JokeA is by the first model of the pair and
+// Winner is LEFT when it won.
+type Example struct {
+	ChoiceID string
+	ThemeID  string
+	Theme    string
+	JokeA    string
+	JokeB    string
+	Winner   choicesv1.Winner
+	RatedAt  time.Time
+}
+
+func (e Example) swap() Example {
+	e.JokeA, e.JokeB = e.JokeB, e.JokeA
+	switch e.Winner {
+	case choicesv1.Winner_LEFT:
+		e.Winner = choicesv1.Winner_RIGHT
+	case choicesv1.Winner_RIGHT:
+		e.Winner = choicesv1.Winner_LEFT
+	}
+	return e
+}
+
+// HeadToHead summarizes the votes between ModelA and ModelB.
+type HeadToHead struct {
+	ModelA string
+	ModelB string
+	Record
+	// Themes are ordered by number of votes, most voted first.
+	Themes []ThemeRecord
+	// Examples are the most recently rated pairs, newest first.
+	Examples []Example
+}
+
+// Swap returns the same head-to-head seen from ModelB's side.
+func (h HeadToHead) Swap() HeadToHead {
+	swapped := HeadToHead{
+		ModelA:   h.ModelB,
+		ModelB:   h.ModelA,
+		Record:   h.Record.swap(),
+		Themes:   make([]ThemeRecord, len(h.Themes)),
+		Examples: make([]Example, len(h.Examples)),
+	}
+	for i, theme := range h.Themes {
+		theme.Record = theme.Record.swap()
+		swapped.Themes[i] = theme
+	}
+	for i, example := range h.Examples {
+		swapped.Examples[i] = example.swap()
+	}
+	return swapped
+}
+
+// HeadToHeads counts the outcomes of rated choices that pass the filter for
+// every pair of models that met at least once. Pairs are ordered by model
+// names and ModelA sorts before ModelB. At most maxExamples examples are kept
+// per pair.
+func HeadToHeads(choices []storage.Choice, jokes []storage.Joke, filter Filter, maxExamples int) []HeadToHead {
+	jokesByID := make(map[string]*storage.Joke, len(jokes))
+	for i, joke := range jokes {
+		if joke.Model != "" {
+			jokesByID[joke.ID] = &jokes[i]
+		}
+	}
+
+	pairs := make(map[modelPair]*HeadToHead)
+	themes := make(map[modelPair]map[string]*ThemeRecord)
+	for _, choice := range choices {
+		leftJoke, rightJoke, ok := choice.Jokes(jokesByID)
+		if !ok || !storage.IsRated(choice.Winner) {
+			continue
+		}
+		if leftJoke.Model == rightJoke.Model || !filter.Match(&choice, leftJoke, rightJoke) {
+			continue
+		}
+
+		ratedAt := choice.CreatedAt
+		if choice.RatedAt != nil {
+			ratedAt = *choice.RatedAt
+		}
+		example := Example{
+			ChoiceID: choice.ID,
+			ThemeID:  choice.ThemeID,
+			Theme:    leftJoke.Theme,
+			JokeA:    leftJoke.Text,
+			JokeB:    rightJoke.Text,
+			Winner:   *choice.Winner,
+			RatedAt:  ratedAt,
+		}
+		key := modelPair{leftJoke.Model, rightJoke.Model}
+		if key.right < key.left {
+			key = modelPair{key.right, key.left}
+			example = example.swap()
+		}
+
+		pair, ok := pairs[key]
+		if !ok {
+			pair = &HeadToHead{ModelA: key.left, ModelB: key.right}
+			pairs[key] = pair
+			themes[key] = make(map[string]*ThemeRecord)
+		}
+		pair.Record.add(example.Winner)
+		theme, ok := themes[key][example.ThemeID]
+		if !ok {
+			theme = &ThemeRecord{ThemeID: example.ThemeID, Theme: example.Theme}
+			themes[key][example.ThemeID] = theme
+		}
+		theme.Record.add(example.Winner)
+		pair.Examples = append(pair.Examples, example)
+	}
+
+	result := make([]HeadToHead, 0, len(pairs))
+	for key, pair := range pairs {
+		for _, theme := range themes[key] {
+			pair.Themes = append(pair.Themes, *theme)
+		}
+		sort.Slice(pair.Themes, func(i, j int) bool {
+			if pair.Themes[i].Votes() != pair.Themes[j].Votes() {
+				return pair.Themes[i].Votes() > pair.Themes[j].Votes()
+			}
+			return pair.Themes[i].ThemeID < pair.Themes[j].ThemeID
+		})
+
+		sort.Slice(pair.Examples, func(i, j int) bool {
+			if !pair.Examples[i].RatedAt.Equal(pair.Examples[j].RatedAt) {
+				return pair.Examples[i].RatedAt.After(pair.Examples[j].RatedAt)
+			}
+			return pair.Examples[i].ChoiceID < pair.Examples[j].ChoiceID
+		})
+		if len(pair.Examples) > maxExamples {
+			pair.Examples = pair.Examples[:maxExamples]
+		}
+		result = append(result, *pair)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].ModelA != result[j].ModelA {
+			return result[i].ModelA < result[j].ModelA
+		}
+		return result[i].ModelB < result[j].ModelB
+	})
+	return result
+}
@@ -0,0 +1,255 @@
+// Package leaderboard aggregates rated choices into model leaderboards.
+package leaderboard
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+
+	choicesv1 "github.com/SaveTheRbtz/humor/gen/go/proto"
+	"github.com/SaveTheRbtz/humor/server/internal/ratings"
+	"github.com/SaveTheRbtz/humor/server/internal/storage"
+)
+
+// ErrNoRatedChoices is returned when there is nothing to rank.
+var ErrNoRatedChoices = errors.New("no rated choices")
+
+type Options struct {
+	Bootstrap ratings.BootstrapOptions
+	Solver    ratings.SolverOptions
+}
+
+// DefaultSeed is the bootstrap seed unless another one is given, so that
+// reruns over the same votes produce the same intervals.
+const DefaultSeed = 42
+
+// DefaultOptions returns the settings used by the leaderboard job.
+func DefaultOptions() Options {
+	return Options{
+		Bootstrap: ratings.BootstrapOptions{
+			Samples:    1000,
+			Confidence: 0.95,
+			Seed:       DefaultSeed,
+		},
+		Solver: ratings.DefaultSolverOptions(),
+	}
+}
+
+// Filter selects the votes that a leaderboard is computed from. The zero
+// value selects all votes.
+type Filter struct {
+	// ThemeSet keeps votes where both jokes belong to the theme set.
+	ThemeSet string
+	// Policy keeps votes where both jokes were generated with the policy.
+	Policy string
+	// Start and End bound the creation time of the choice: [Start, End).
+	Start time.Time
+	End   time.Time
+	// ExcludeKnown drops votes where the user already knew a joke.
+	ExcludeKnown bool
+}
+
+// IsZero reports whether the filter selects all votes.
+func (f Filter) IsZero() bool {
+	return f == Filter{}
+}
+
+// Match reports whether the vote on choice passes the filter.
+func (f Filter) Match(choice *storage.Choice, left, right *storage.Joke) bool {
+	if f.ThemeSet != "" && (left.ThemeSet != f.ThemeSet || right.ThemeSet != f.ThemeSet) {
+		return false
+	}
+	if f.Policy != "" && (left.Policy != f.Policy || right.Policy != f.Policy) {
+		return false
+	}
+	if !f.Start.IsZero() && choice.CreatedAt.Before(f.Start) {
+		return false
+	}
+	if !f.End.IsZero() && !choice.CreatedAt.Before(f.End) {
+		return false
+	}
+	if f.ExcludeKnown && choice.Known != nil {
+		switch *choice.Known {
+		case choicesv1.Winner_LEFT, choicesv1.Winner_RIGHT, choicesv1.Winner_BOTH:
+			return false
+		}
+	}
+	return true
+}
+
+type modelPair struct {
+	left, right string
+}
+
+type Result struct {
+	Leaderboard  storage.Leaderboard
+	ModelWeights storage.ModelWeights
+}
+
+// Compute turns rated choices that pass the filter into a leaderboard and a
+// matrix of pair sampling weights that prefers model pairs with fewer votes.
+func Compute(
+	logger *zap.Logger,
+	choices []storage.Choice,
+	jokes []storage.Joke,
+	filter Filter,
+	opts Options,
+) (*Result, error) {
+	if len(choices) == 0 {
+		return nil, ErrNoRatedChoices
+	}
+
+	jokesByID := make(map[string]*storage.Joke, len(jokes))
+	for i, joke := range jokes {
+		if joke.Model != "" {
+			jokesByID[joke.ID] = &jokes[i]
+		}
+	}
+
+	modelVoteMatrix := make(map[modelPair]int)
+	modelVotes := make(map[string]int64)
+	modelVotesGood := make(map[string]int64)
+	modelVotesBad := make(map[string]int64)
+	comparisons := make([]ratings.Comparison, 0, len(choices))
+	skipCount := 0
+	for _, choice := range choices {
+		leftJoke, rightJoke, ok := choice.Jokes(jokesByID)
+		if !ok || choice.Winner == nil {
+			logger.Debug("Skipping choice with unknown jokes", zap.String("choice_id", choice.ID))
+			skipCount++
+			continue
+		}
+		if !filter.Match(&choice, leftJoke, rightJoke) {
+			continue
+		}
+		leftModel, rightModel := leftJoke.Model, rightJoke.Model
+		if leftModel == rightModel {
+			logger.Debug("Skipping same model", zap.String("model", leftModel))
+			skipCount++
+			continue
+		}
+
+		modelVoteMatrix[modelPair{leftModel, rightModel}]++
+		modelVoteMatrix[modelPair{rightModel, leftModel}]++
+		modelVotes[leftModel]++
+		modelVotes[rightModel]++
+
+		outcome, ok := ratings.OutcomeFromWinner(*choice.Winner)
+		if !ok {
+			// If both jokes were bad we can't use this data for rating.
+			skipCount++
+			continue
+		}
+		comparisons = append(comparisons, ratings.Comparison{X: leftModel, Y: rightModel, Outcome: outcome})
+		switch *choice.Winner {
+		case choicesv1.Winner_LEFT:
+			modelVotesGood[leftModel]++
+			modelVotesBad[rightModel]++
+		case choicesv1.Winner_RIGHT:
+			modelVotesGood[rightModel]++
+			modelVotesBad[leftModel]++
+		case choicesv1.Winner_BOTH:
+			modelVotesGood[leftModel]++
+			modelVotesGood[rightModel]++
+		}
+	}
+	logger.Info("Choices processed successfully",
+		zap.Int("comparisons", len(comparisons)),
+		zap.Int("skipped", skipCount),
+	)
+	if len(comparisons) == 0 {
+		return nil, fmt.Errorf("no valid comparisons found: %w", ErrNoRatedChoices)
+	}
+
+	eloSystem := ratings.EloSystem(ratings.DefaultEloOptions())
+	newmanSystem := ratings.NewmanSystem(0.5, opts.Solver)
+	confidenceIntervals := ratings.Bootstrap(
+		comparisons,
+		map[string]ratings.System{
+			"elo":    eloSystem,
+			"newman": newmanSystem,
+		},
+		opts.Bootstrap,
+	)
+	eloScores := eloSystem(comparisons)
+	newmanScores := newmanSystem(comparisons)
+
+	models := make([]string, 0, len(modelVotes))
+	for model := range modelVotes {
+		models = append(models, model)
+	}
+	sort.Strings(models)
+
+	entries := make([]storage.LeaderboardEntry, 0, len(models))
+	for _, model := range models {
+		eloCI, ok := confidenceIntervals["elo"][model]
+		if !ok {
+			eloCI = ratings.Interval{Lower: eloScores[model], Median: eloScores[model], Upper: eloScores[model]}
+		}
+		newmanCI, ok := confidenceIntervals["newman"][model]
+		if !ok {
+			newmanCI = ratings.Interval{Lower: newmanScores[model], Median: newmanScores[model], Upper: newmanScores[model]}
+		}
+		logger.Debug("Leaderboard entry",
+			zap.String("model", model),
+			zap.Int64("votes", modelVotes[model]),
+			zap.Float64("elo", eloScores[model]),
+			zap.Any("elo_ci", eloCI),
+			zap.Float64("newman", newmanScores[model]),
+			zap.Any("newman_ci", newmanCI),
+		)
+
+		// Scores are bootstrap medians and CIs are distances from them.
+		entries = append(entries, storage.LeaderboardEntry{
+			Model:         model,
+			Votes:         modelVotes[model],
+			VotesGood:     modelVotesGood[model],
+			VotesBad:      modelVotesBad[model],
+			EloScore:      eloCI.Median,
+			EloCiLower:    eloCI.Median - eloCI.Lower,
+			EloCiUpper:    eloCI.Upper - eloCI.Median,
+			NewmanScore:   newmanCI.Median,
+			NewmanCiLower: newmanCI.Median - newmanCI.Lower,
+			NewmanCiUpper: newmanCI.Upper - newmanCI.Median,
+		})
+	}
+
+	return &Result{
+		Leaderboard: storage.Leaderboard{
+			Entries: entries,
+		},
+		ModelWeights: modelWeights(models, modelVoteMatrix),
+	}, nil
+}
+
+// modelWeights builds a row-normalized matrix where the weight of a pair is
+// inversely proportional to the number of votes it already has.
+func modelWeights(models []string, modelVoteMatrix map[modelPair]int) storage.ModelWeights {
+	n := len(models)
+	weights := make([]float64, n*n)
+	for i, left := range models {
+		var rowSum float64
+		for j, right := range models {
+			if i == j {
+				continue
+			}
+			weights[i*n+j] = 1 / float64(modelVoteMatrix[modelPair{left, right}]+1)
+			rowSum += weights[i*n+j]
+		}
+		if rowSum == 0 {
+			continue
+		}
+		for j := range models {
+			weights[i*n+j] /= rowSum
+		}
+	}
+
+	return storage.ModelWeights{
+		ModelWeights: weights,
+		Shape:        []int{n, n},
+		Models:       models,
+	}
+}
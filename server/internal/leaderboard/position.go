@@ -0,0 +1,118 @@
+package leaderboard
+
+import (
+	"sort"
+
+	choicesv1 "github.com/SaveTheRbtz/humor/gen/go/proto"
+	"github.com/SaveTheRbtz/humor/server/internal/ratings"
+	"github.com/SaveTheRbtz/humor/server/internal/storage"
+)
+
+// PositionEntry shows how a model fares on each side of the screen.
+type PositionEntry struct {
+	Model string
+	// Left and Right count votes with the model's joke on that side, from
+	// the model's side.
+	Left  Record
+	Right Record
+	// Score and CorrectedScore are Bradley-Terry scores without and with
+	// the position term. Both sum to one over all models.
+	Score          float64
+	CorrectedScore float64
+}
+
+// PositionReport measures how much the screen side affects votes.
+type PositionReport struct {
+	// Record counts votes from the left joke's side. Unlike the entries it
+	// includes pairs of jokes by the same model.
+	Record
+	// Advantage is the fitted odds multiplier of the left side, one when
+	// there is no position bias.
+	Advantage float64
+	Entries   []PositionEntry
+}
+
+func swapWinner(winner choicesv1.Winner) choicesv1.Winner {
+	switch winner {
+	case choicesv1.Winner_LEFT:
+		return choicesv1.Winner_RIGHT
+	case choicesv1.Winner_RIGHT:
+		return choicesv1.Winner_LEFT
+	}
+	return winner
+}
+
+// PositionBias builds a PositionReport from rated choices that pass the
+// filter. With randomizedOnly, only choices with a randomized side
+// assignment are used, since before that the side was correlated with the
+// model.
+func PositionBias(
+	choices []storage.Choice,
+	jokes []storage.Joke,
+	filter Filter,
+	randomizedOnly bool,
+	opts ratings.SolverOptions,
+) (*PositionReport, error) {
+	jokesByID := make(map[string]*storage.Joke, len(jokes))
+	for i, joke := range jokes {
+		if joke.Model != "" {
+			jokesByID[joke.ID] = &jokes[i]
+		}
+	}
+
+	report := &PositionReport{}
+	entries := make(map[string]*PositionEntry)
+	entry := func(model string) *PositionEntry {
+		if _, ok := entries[model]; !ok {
+			entries[model] = &PositionEntry{Model: model}
+		}
+		return entries[model]
+	}
+	comparisons := make([]ratings.Comparison, 0, len(choices))
+	for _, choice := range choices {
+		leftJoke, rightJoke, ok := choice.Jokes(jokesByID)
+		if !ok || !storage.IsRated(choice.Winner) {
+			continue
+		}
+		if randomizedOnly && choice.Swapped == nil {
+			continue
+		}
+		if !filter.Match(&choice, leftJoke, rightJoke) {
+			continue
+		}
+		winner := *choice.Winner
+		report.Record.add(winner)
+		if leftJoke.Model == rightJoke.Model {
+			continue
+		}
+		entry(leftJoke.Model).Left.add(winner)
+		entry(rightJoke.Model).Right.add(swapWinner(winner))
+
+		if outcome, ok := ratings.OutcomeFromWinner(winner); ok {
+			comparisons = append(comparisons, ratings.Comparison{X: leftJoke.Model, Y: rightJoke.Model, Outcome: outcome})
+		}
+	}
+	if report.Votes() == 0 {
+		return nil, ErrNoRatedChoices
+	}
+
+	report.Advantage = 1
+	if len(comparisons) > 0 {
+		scores := ratings.BradleyTerry(comparisons, opts)
+		correctedScores, advantage := ratings.BradleyTerryPosition(comparisons, opts)
+		report.Advantage = advantage
+		for model, score := range scores {
+			entry(model).Score = score
+			entry(model).CorrectedScore = correctedScores[model]
+		}
+	}
+
+	report.Entries = make([]PositionEntry, 0, len(entries))
+	for _, entry := range entries {
+		report.Entries = append(report.Entries, *entry)
+	}
+	sort.Slice(report.Entries, func(i, j int) bool {
+		return report.Entries[i].Model < report.Entries[j].Model
+	})
+	return report, nil
+}
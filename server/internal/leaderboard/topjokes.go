@@ -0,0 +1,133 @@
+package leaderboard
+
+import (
+	"fmt"
+	"sort"
+
+	"go.uber.org/zap"
+
+	"github.com/SaveTheRbtz/humor/server/internal/ratings"
+	"github.com/SaveTheRbtz/humor/server/internal/storage"
+)
+
+type TopJokesOptions struct {
+	// MinVotes is the number of votes a joke needs to be ranked.
+	MinVotes int64
+	// Limit is the maximum number of ranked jokes, all of them if zero.
+	Limit int
+	// Prior is the number of virtual wins and losses of every joke, see
+	// ratings.SparseBradleyTerry.
+	Prior     float64
+	Bootstrap ratings.BootstrapOptions
+	Solver    ratings.SolverOptions
+}
+
+// DefaultTopJokesOptions returns the settings used by the leaderboard job.
+func DefaultTopJokesOptions() TopJokesOptions {
+	return TopJokesOptions{
+		MinVotes: 5,
+		Limit:    1000,
+		Prior:    1,
+		// Fewer samples than for models: every sample fits all jokes.
+		Bootstrap: ratings.BootstrapOptions{
+			Samples:    100,
+			Confidence: 0.95,
+			Seed:       DefaultSeed,
+		},
+		Solver: ratings.DefaultSolverOptions(),
+	}
+}
+
+// TopJokes ranks active jokes by their Bradley-Terry score over individual
+// joke IDs. Every rated choice counts, including ones between jokes of the
+// same model, but only jokes with at least opts.MinVotes votes are ranked.
+func TopJokes(
+	logger *zap.Logger,
+	choices []storage.Choice,
+	jokes []storage.Joke,
+	opts TopJokesOptions,
+) (*storage.TopJokes, error) {
+	jokesByID := make(map[string]*storage.Joke, len(jokes))
+	for i, joke := range jokes {
+		jokesByID[joke.ID] = &jokes[i]
+	}
+
+	votes := make(map[string]int64)
+	comparisons := make([]ratings.Comparison, 0, len(choices))
+	for _, choice := range choices {
+		_, leftOK := jokesByID[choice.LeftJokeID]
+		_, rightOK := jokesByID[choice.RightJokeID]
+		if !leftOK || !rightOK || choice.Winner == nil || choice.LeftJokeID == choice.RightJokeID {
+			continue
+		}
+		outcome, ok := ratings.OutcomeFromWinner(*choice.Winner)
+		if !ok {
+			continue
+		}
+		comparisons = append(comparisons, ratings.Comparison{X: choice.LeftJokeID, Y: choice.RightJokeID, Outcome: outcome})
+		votes[choice.LeftJokeID]++
+		votes[choice.RightJokeID]++
+	}
+	if len(comparisons) == 0 {
+		return nil, fmt.Errorf("no valid joke comparisons found: %w", ErrNoRatedChoices)
+	}
+
+	system := ratings.SparseBradleyTerrySystem(opts.Prior, opts.Solver)
+	scores := system(comparisons)
+	confidenceIntervals := ratings.Bootstrap(
+		comparisons,
+		map[string]ratings.System{"bt": system},
+		opts.Bootstrap,
+	)["bt"]
+
+	entries := make([]storage.TopJoke, 0)
+	for jokeID, score := range scores {
+		if votes[jokeID] < opts.MinVotes {
+			continue
+		}
+		ci, ok := confidenceIntervals[jokeID]
+		if !ok {
+			ci = ratings.Interval{Lower: score, Median: score, Upper: score}
+		}
+		joke := jokesByID[jokeID]
+		// Like the leaderboard, scores are bootstrap medians and CIs are
+		// distances from them.
+		entries = append(entries, storage.TopJoke{
+			JokeID:       jokeID,
+			Text:         joke.Text,
+			ThemeID:      joke.ThemeID,
+			Theme:        joke.Theme,
+			Model:        joke.Model,
+			Policy:       joke.Policy,
+			Score:        ci.Median,
+			ScoreCiLower: ci.Median - ci.Lower,
+			ScoreCiUpper: ci.Upper - ci.Median,
+			Votes:        votes[jokeID],
+		})
+	}
+	logger.Info("Jokes ranked successfully",
+		zap.Int("comparisons", len(comparisons)),
+		zap.Int("jokes", len(scores)),
+		zap.Int("ranked", len(entries)),
+	)
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("no jokes with %d votes: %w", opts.MinVotes, ErrNoRatedChoices)
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Score != entries[j].Score {
+			return entries[i].Score > entries[j].Score
+		}
+		if entries[i].Votes != entries[j].Votes {
+			return entries[i].Votes > entries[j].Votes
+		}
+		return entries[i].JokeID < entries[j].JokeID
+	})
+	if opts.Limit > 0 && len(entries) > opts.Limit {
+		entries = entries[:opts.Limit]
+	}
+
+	return &storage.TopJokes{
+		Entries: entries,
+	}, nil
+}
@@ -0,0 +1,172 @@
+// Package metrics defines the Prometheus metrics of the arena server and the
+// interceptors that record request metrics.
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+const namespace = "arena"
+
+var (
+	grpcRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "grpc_requests_total",
+		Help:      "gRPC requests by method and status code.",
+	}, []string{"method", "code"})
+	grpcDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "grpc_request_duration_seconds",
+		Help:      "gRPC request latency by method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method"})
+
+	httpRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "http_requests_total",
+		Help:      "HTTP requests by route, method and status code.",
+	}, []string{"route", "method", "code"})
+	httpDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "HTTP request latency by route and method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"route", "method"})
+
+	// PairsServed counts joke pairs returned by GetChoices.
+	PairsServed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "pairs_served_total",
+		Help:      "Joke pairs served by sampler.",
+	}, []string{"sampler"})
+	// Votes counts stored votes by winner.
+	Votes = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "votes_total",
+		Help:      "Votes by winner.",
+	}, []string{"winner"})
+	// ModelVotes counts stored votes per model, named as on the leaderboard,
+	// once for each joke of the rated pair.
+	ModelVotes = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "model_votes_total",
+		Help:      "Votes per model.",
+	}, []string{"model"})
+	// SamplerFallbacks counts samples that fell back to a simpler strategy.
+	SamplerFallbacks = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "sampler_fallbacks_total",
+		Help:      "Sampler fallbacks by sampler and reason.",
+	}, []string{"sampler", "reason"})
+	// CacheRequests counts cache lookups by cache and result, see CacheHit
+	// and CacheMiss.
+	CacheRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "cache_requests_total",
+		Help:      "Cache lookups by cache and result.",
+	}, []string{"cache", "result"})
+	// StorageDuration observes the latency of storage calls.
+	StorageDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "storage_request_duration_seconds",
+		Help:      "Storage call latency by backend, method and result.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"backend", "method", "result"})
+	// DependencyUp is 1 if the last health check of a dependency passed and
+	// 0 otherwise.
+	DependencyUp = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "dependency_up",
+		Help:      "Result of the last health check by check name.",
+	}, []string{"check"})
+)
+
+// Cache lookup results.
+const (
+	CacheHit  = "hit"
+	CacheMiss = "miss"
+)
+
+// CacheLookup records a cache lookup.
+func CacheLookup(cache string, hit bool) {
+	result := CacheMiss
+	if hit {
+		result = CacheHit
+	}
+	CacheRequests.WithLabelValues(cache, result).Inc()
+}
+
+// Handler serves the metrics of the default registry.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// UnaryServerInterceptor records the count and latency of unary gRPC calls.
+func UnaryServerInterceptor(
+	ctx context.Context,
+	req any,
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	grpcDuration.WithLabelValues(info.FullMethod).Observe(time.Since(start).Seconds())
+	grpcRequests.WithLabelValues(info.FullMethod, status.Code(err).String()).Inc()
+	return resp, err
+}
+
+// GatewayMiddleware records the count and latency of gateway requests by
+// their route pattern.
+func GatewayMiddleware(next runtime.HandlerFunc) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		route := "unknown"
+		if pattern, ok := runtime.HTTPPattern(r.Context()); ok {
+			route = pattern.String()
+		}
+		observe(route, w, r, func(w http.ResponseWriter) {
+			next(w, r, pathParams)
+		})
+	}
+}
+
+// Middleware records the count and latency of requests under a fixed route
+// name.
+func Middleware(route string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		observe(route, w, r, func(w http.ResponseWriter) {
+			next.ServeHTTP(w, r)
+		})
+	})
+}
+
+func observe(route string, w http.ResponseWriter, r *http.Request, serve func(http.ResponseWriter)) {
+	start := time.Now()
+	recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+	serve(recorder)
+	httpDuration.WithLabelValues(route, r.Method).Observe(time.Since(start).Seconds())
+	httpRequests.WithLabelValues(route, r.Method, strconv.Itoa(recorder.status)).Inc()
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
@@ -0,0 +1,115 @@
+package ratings
+
+import (
+	insecureRand "math/rand/v2"
+	"runtime"
+	"sort"
+	"sync"
+)
+
+// Interval holds bootstrap percentiles of a score.
+type Interval struct {
+	Lower  float64
+	Median float64
+	Upper  float64
+}
+
+type BootstrapOptions struct {
+	// Samples is the number of bootstrap resamples.
+	Samples int
+	// Confidence is the coverage of the interval, e.g. 0.95.
+	Confidence float64
+	// Seed makes the resampling reproducible. The result does not depend on
+	// the number of workers.
+	Seed uint64
+	// Workers is the number of goroutines; GOMAXPROCS when zero.
+	Workers int
+}
+
+// Bootstrap resamples the comparisons with replacement and returns, for
+// every rating system and player, the lower, median and upper percentiles of
+// the score distribution. Players missing from a resample are left out of
+// that sample's distribution.
+func Bootstrap(
+	comparisons []Comparison,
+	systems map[string]System,
+	opts BootstrapOptions,
+) map[string]map[string]Interval {
+	names := make([]string, 0, len(systems))
+	for name := range systems {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	// results[b][s] are the scores of system names[s] on resample b.
+	results := make([][]Scores, opts.Samples)
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	samples := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resample := make([]Comparison, len(comparisons))
+			for b := range samples {
+				// Every resample has its own stream so that the result does
+				// not depend on scheduling.
+				random := insecureRand.New(insecureRand.NewPCG(opts.Seed, uint64(b)))
+				for i := range resample {
+					resample[i] = comparisons[random.IntN(len(comparisons))]
+				}
+				results[b] = make([]Scores, len(names))
+				for s, name := range names {
+					results[b][s] = systems[name](resample)
+				}
+			}
+		}()
+	}
+	if len(comparisons) > 0 {
+		for b := 0; b < opts.Samples; b++ {
+			samples <- b
+		}
+	}
+	close(samples)
+	wg.Wait()
+
+	intervals := make(map[string]map[string]Interval, len(names))
+	for s, name := range names {
+		distributions := make(map[string][]float64)
+		for _, result := range results {
+			if result == nil {
+				continue
+			}
+			for player, score := range result[s] {
+				distributions[player] = append(distributions[player], score)
+			}
+		}
+		intervals[name] = make(map[string]Interval, len(distributions))
+		for player, dist := range distributions {
+			intervals[name][player] = Percentiles(dist, opts.Confidence)
+		}
+	}
+	return intervals
+}
+
+// Percentiles sorts dist in place and returns its confidence interval and
+// median using the same index arithmetic as the Python leaderboard job.
+func Percentiles(dist []float64, confidence float64) Interval {
+	if len(dist) == 0 {
+		return Interval{}
+	}
+	sort.Float64s(dist)
+	clamp := func(i int) int {
+		return max(0, min(i, len(dist)-1))
+	}
+	n := float64(len(dist))
+	return Interval{
+		Lower:  dist[clamp(int((1.0-confidence)/2*n))],
+		Median: dist[clamp(int(0.5*n)-1)],
+		Upper:  dist[clamp(int((1.0+confidence)/2*n)-1)],
+	}
+}
@@ -0,0 +1,150 @@
+package ratings
+
+import "math"
+
+type SolverOptions struct {
+	// Tolerance is the maximum per-player score change at convergence.
+	Tolerance float64
+	// Limit is the maximum number of iterations.
+	Limit int
+}
+
+// DefaultSolverOptions matches the solver settings of the leaderboard job.
+func DefaultSolverOptions() SolverOptions {
+	return SolverOptions{
+		Tolerance: 1e-6,
+		Limit:     1000,
+	}
+}
+
+// BradleyTerry computes Bradley-Terry scores with the minorization-
+// maximization algorithm of Hunter (2004). A draw counts as half a win for
+// both players. Scores are normalized to sum to one.
+func BradleyTerry(comparisons []Comparison, opts SolverOptions) Scores {
+	idx := newIndex(comparisons)
+	n := len(idx.players)
+	wins, ties := idx.matrices(comparisons)
+
+	matrix := make([][]float64, n)
+	totalWins := make([]float64, n)
+	for i := range matrix {
+		matrix[i] = make([]float64, n)
+		for j := range matrix[i] {
+			matrix[i][j] = wins[i][j] + ties[i][j]/2
+			totalWins[i] += matrix[i][j]
+		}
+	}
+
+	scores := make([]float64, n)
+	for i := range scores {
+		scores[i] = 1 / float64(n)
+	}
+	next := make([]float64, n)
+
+	for iteration := 0; iteration < opts.Limit; iteration++ {
+		var sum float64
+		for i := 0; i < n; i++ {
+			var denominator float64
+			for j := 0; j < n; j++ {
+				games := matrix[i][j] + matrix[j][i]
+				if i == j || games == 0 {
+					continue
+				}
+				denominator += games / (scores[i] + scores[j])
+			}
+			next[i] = totalWins[i] / denominator
+			if math.IsNaN(next[i]) || math.IsInf(next[i], 0) {
+				next[i] = 0
+			}
+			sum += next[i]
+		}
+		if sum > 0 {
+			for i := range next {
+				next[i] /= sum
+			}
+		}
+
+		converged := maxAbsDiff(next, scores) <= opts.Tolerance
+		scores, next = next, scores
+		if converged {
+			break
+		}
+	}
+
+	return idx.scores(scores)
+}
+
+// BradleyTerrySystem binds options to BradleyTerry.
+func BradleyTerrySystem(opts SolverOptions) System {
+	return func(comparisons []Comparison) Scores {
+		return BradleyTerry(comparisons, opts)
+	}
+}
+
+// Newman computes Bradley-Terry scores with ties (Davidson model) using the
+// iteration from M. E. J. Newman, "Efficient computation of rankings from
+// pairwise comparisons" (2023). v is the initial tie parameter; the fitted
+// value is returned alongside the scores.
+func Newman(comparisons []Comparison, v float64, opts SolverOptions) (Scores, float64) {
+	idx := newIndex(comparisons)
+	n := len(idx.players)
+	wins, ties := idx.matrices(comparisons)
+
+	scores := make([]float64, n)
+	for i := range scores {
+		scores[i] = 1
+	}
+	next := make([]float64, n)
+
+	for iteration := 0; iteration < opts.Limit; iteration++ {
+		var vNumerator, vDenominator float64
+		for i := 0; i < n; i++ {
+			var numerator, denominator float64
+			for j := 0; j < n; j++ {
+				if i == j {
+					continue
+				}
+				sqrtProduct := math.Sqrt(scores[i] * scores[j])
+				common := scores[i] + scores[j] + 2*v*sqrtProduct
+				if common == 0 {
+					continue
+				}
+				numerator += (wins[i][j] + ties[i][j]/2) * (scores[j] + v*sqrtProduct) / common
+				denominator += (wins[j][i] + ties[j][i]/2) * (1 + v*math.Sqrt(scores[j]/scores[i])) / common
+				vNumerator += ties[i][j] * (scores[i] + scores[j]) / common
+				vDenominator += 2 * wins[i][j] * sqrtProduct / common
+			}
+			next[i] = numerator / denominator
+			if math.IsNaN(next[i]) || math.IsInf(next[i], 0) {
+				next[i] = scores[i]
+			}
+		}
+		if nextV := vNumerator / 2 / vDenominator; !math.IsNaN(nextV) && !math.IsInf(nextV, 0) {
+			v = nextV
+		}
+
+		converged := maxAbsDiff(next, scores) <= opts.Tolerance
+		scores, next = next, scores
+		if converged {
+			break
+		}
+	}
+
+	return idx.scores(scores), v
+}
+
+// NewmanSystem binds the initial tie parameter and options to Newman.
+func NewmanSystem(v float64, opts SolverOptions) System {
+	return func(comparisons []Comparison) Scores {
+		scores, _ := Newman(comparisons, v, opts)
+		return scores
+	}
+}
+
+func maxAbsDiff(a, b []float64) float64 {
+	var diff float64
+	for i := range a {
+		diff = max(diff, math.Abs(a[i]-b[i]))
+	}
+	return diff
+}
@@ -0,0 +1,63 @@
+package ratings
+
+import "math"
+
+type EloOptions struct {
+	Initial float64
+	Base    float64
+	Scale   float64
+	K       float64
+	// WinWeight and TieWeight are the actual scores of a win and a draw.
+	WinWeight float64
+	TieWeight float64
+}
+
+// DefaultEloOptions matches the defaults of evalica.elo.
+func DefaultEloOptions() EloOptions {
+	return EloOptions{
+		Initial:   1000,
+		Base:      10,
+		Scale:     400,
+		K:         4,
+		WinWeight: 1,
+		TieWeight: 0.5,
+	}
+}
+
+// Elo computes online Elo ratings in a single pass over the comparisons.
+func Elo(comparisons []Comparison, opts EloOptions) Scores {
+	idx := newIndex(comparisons)
+	ratings := make([]float64, len(idx.players))
+	for i := range ratings {
+		ratings[i] = opts.Initial
+	}
+
+	for _, c := range comparisons {
+		x, y := idx.ids[c.X], idx.ids[c.Y]
+		qx := math.Pow(opts.Base, ratings[x]/opts.Scale)
+		qy := math.Pow(opts.Base, ratings[y]/opts.Scale)
+		ex := qx / (qx + qy)
+		ey := qy / (qx + qy)
+
+		var sx, sy float64
+		switch c.Outcome {
+		case X:
+			sx = opts.WinWeight
+		case Y:
+			sy = opts.WinWeight
+		case Draw:
+			sx, sy = opts.TieWeight, opts.TieWeight
+		}
+		ratings[x] += opts.K * (sx - ex)
+		ratings[y] += opts.K * (sy - ey)
+	}
+
+	return idx.scores(ratings)
+}
+
+// EloSystem binds options to Elo.
+func EloSystem(opts EloOptions) System {
+	return func(comparisons []Comparison) Scores {
+		return Elo(comparisons, opts)
+	}
+}
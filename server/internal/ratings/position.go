@@ -0,0 +1,100 @@
+package ratings
+
+import "math"
+
+// BradleyTerryPosition computes Bradley-Terry scores together with the
+// advantage of the first position, X, using the home-field model and
+// minorization-maximization algorithm of Hunter (2004): X beats Y with
+// probability advantage*score[X] / (advantage*score[X] + score[Y]). An
+// advantage of one means there is no position bias. A draw counts as half a
+// win for both players. Scores are normalized to sum to one.
+func BradleyTerryPosition(comparisons []Comparison, opts SolverOptions) (Scores, float64) {
+	idx := newIndex(comparisons)
+	n := len(idx.players)
+	wins, ties := idx.matrices(comparisons)
+
+	// games[i][j] counts comparisons with i as X and j as Y, and
+	// firstWins[i][j] the wins of i among them.
+	games := make([][]float64, n)
+	firstWins := make([][]float64, n)
+	for i := range games {
+		games[i] = make([]float64, n)
+		firstWins[i] = make([]float64, n)
+	}
+	for _, c := range comparisons {
+		i, j := idx.ids[c.X], idx.ids[c.Y]
+		games[i][j]++
+		switch c.Outcome {
+		case X:
+			firstWins[i][j]++
+		case Draw:
+			firstWins[i][j] += 0.5
+		}
+	}
+
+	totalWins := make([]float64, n)
+	var totalFirstWins float64
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			totalWins[i] += wins[i][j] + ties[i][j]/2
+			totalFirstWins += firstWins[i][j]
+		}
+	}
+
+	scores := make([]float64, n)
+	for i := range scores {
+		scores[i] = 1 / float64(n)
+	}
+	next := make([]float64, n)
+	advantage := 1.0
+
+	for iteration := 0; iteration < opts.Limit; iteration++ {
+		var sum float64
+		for i := 0; i < n; i++ {
+			var denominator float64
+			for j := 0; j < n; j++ {
+				if i == j {
+					continue
+				}
+				if games[i][j] > 0 {
+					denominator += games[i][j] * advantage / (advantage*scores[i] + scores[j])
+				}
+				if games[j][i] > 0 {
+					denominator += games[j][i] / (advantage*scores[j] + scores[i])
+				}
+			}
+			next[i] = totalWins[i] / denominator
+			if math.IsNaN(next[i]) || math.IsInf(next[i], 0) {
+				next[i] = 0
+			}
+			sum += next[i]
+		}
+		if sum > 0 {
+			for i := range next {
+				next[i] /= sum
+			}
+		}
+
+		var advantageDenominator float64
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				if i != j && games[i][j] > 0 {
+					advantageDenominator += games[i][j] * next[i] / (advantage*next[i] + next[j])
+				}
+			}
+		}
+		nextAdvantage := totalFirstWins / advantageDenominator
+		if math.IsNaN(nextAdvantage) || math.IsInf(nextAdvantage, 0) {
+			nextAdvantage = advantage
+		}
+
+		converged := maxAbsDiff(next, scores) <= opts.Tolerance && math.Abs(nextAdvantage-advantage) <= opts.Tolerance
+		scores, next = next, scores
+		advantage = nextAdvantage
+		if converged {
+			break
+		}
+	}
+
+	return idx.scores(scores), advantage
+}
@@ -0,0 +1,113 @@
+// Package ratings implements pairwise rating systems (Elo and Bradley-Terry)
+// and bootstrap confidence intervals over them. Defaults follow evalica so
+// that scores are comparable with the Python tooling; ratings_test.go checks
+// them against testdata/evalica_reference.json.
+//
+// Intended deviations from evalica:
+//   - Comparisons of a player with itself are ignored, evalica counts them on
+//     the diagonal of the win matrix.
+//   - BradleyTerry starts from scores of 1/n rather than one, which is the
+//     same after the first normalization.
+//   - A Newman score that is not finite keeps its previous value instead of
+//     becoming zero.
+//   - Newman returns the tie parameter fitted in the last iteration rather
+//     than the one its scores were computed with. Both agree within the
+//     solver tolerance.
+package ratings
+
+import (
+	choicesv1 "github.com/SaveTheRbtz/humor/gen/go/proto"
+)
+
+// Outcome is the result of a comparison from the point of view of X.
+type Outcome int
+
+const (
+	// X won the comparison.
+	X Outcome = iota
+	// Y won the comparison.
+	Y
+	// Draw means that both were equally good.
+	Draw
+)
+
+// OutcomeFromWinner maps an arena vote to an Outcome where X is the left
+// joke. NONE and UNSPECIFIED votes carry no preference and are rejected.
+func OutcomeFromWinner(w choicesv1.Winner) (Outcome, bool) {
+	switch w {
+	case choicesv1.Winner_LEFT:
+		return X, true
+	case choicesv1.Winner_RIGHT:
+		return Y, true
+	case choicesv1.Winner_BOTH:
+		return Draw, true
+	}
+	return 0, false
+}
+
+// Comparison is a single pairwise vote between players X and Y.
+type Comparison struct {
+	X, Y    string
+	Outcome Outcome
+}
+
+// Scores maps every player to its rating.
+type Scores map[string]float64
+
+// System computes scores from a list of comparisons.
+type System func(comparisons []Comparison) Scores
+
+// index assigns a dense index to every player in order of appearance.
+type index struct {
+	players []string
+	ids     map[string]int
+}
+
+func newIndex(comparisons []Comparison) *index {
+	idx := &index{
+		players: make([]string, 0),
+		ids:     make(map[string]int),
+	}
+	for _, c := range comparisons {
+		for _, p := range [2]string{c.X, c.Y} {
+			if _, ok := idx.ids[p]; !ok {
+				idx.ids[p] = len(idx.players)
+				idx.players = append(idx.players, p)
+			}
+		}
+	}
+	return idx
+}
+
+func (idx *index) scores(values []float64) Scores {
+	scores := make(Scores, len(idx.players))
+	for i, p := range idx.players {
+		scores[p] = values[i]
+	}
+	return scores
+}
+
+// matrices returns the win matrix, where wins[i][j] counts wins of i over j,
+// and the symmetric tie matrix.
+func (idx *index) matrices(comparisons []Comparison) (wins, ties [][]float64) {
+	n := len(idx.players)
+	wins = make([][]float64, n)
+	ties = make([][]float64, n)
+	for i := range wins {
+		wins[i] = make([]float64, n)
+		ties[i] = make([]float64, n)
+	}
+	for _, c := range comparisons {
+		x, y := idx.ids[c.X], idx.ids[c.Y]
+		switch c.Outcome {
+		case X:
+			wins[x][y]++
+		case Y:
+			wins[y][x]++
+		case Draw:
+			ties[x][y]++
+			ties[y][x]++
+		}
+	}
+	return wins, ties
+}
@@ -0,0 +1,141 @@
+package ratings
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math"
+	"os"
+	"strings"
+	"testing"
+)
+
+// The dataset dump and the scores computed from it by
+// testdata/evalica_reference.py. See the script for how jokes are grouped
+// into players.
+const (
+	datasetPath   = "../../../data/humor_arena.choices.20250812.json"
+	referencePath = "testdata/evalica_reference.json"
+)
+
+type reference struct {
+	Generator    string             `json:"generator"`
+	Buckets      int                `json:"buckets"`
+	Comparisons  int                `json:"comparisons"`
+	Elo          map[string]float64 `json:"elo"`
+	BradleyTerry map[string]float64 `json:"bradley_terry"`
+	Newman       map[string]float64 `json:"newman"`
+	NewmanV      float64            `json:"newman_v"`
+}
+
+func loadReference(t *testing.T) (*reference, []Comparison) {
+	t.Helper()
+
+	data, err := os.ReadFile(referencePath)
+	if errors.Is(err, fs.ErrNotExist) {
+		t.Skipf("%s is missing; generate it with evalica_reference.py", referencePath)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ref reference
+	if err := json.Unmarshal(data, &ref); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(ref.Generator, "evalica ") {
+		t.Fatalf("reference scores come from %q, not from evalica", ref.Generator)
+	}
+
+	data, err = os.ReadFile(datasetPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var choices []struct {
+		Winner    string `json:"winner"`
+		LeftJoke  string `json:"left_joke"`
+		RightJoke string `json:"right_joke"`
+	}
+	if err := json.Unmarshal(data, &choices); err != nil {
+		t.Fatal(err)
+	}
+	bucket := func(text string) string {
+		sum := sha256.Sum256([]byte(text))
+		return fmt.Sprintf("b%d", int(sum[0])%ref.Buckets)
+	}
+	outcomes := map[string]Outcome{"LEFT": X, "RIGHT": Y, "BOTH": Draw}
+
+	var comparisons []Comparison
+	for _, choice := range choices {
+		outcome, ok := outcomes[choice.Winner]
+		x, y := bucket(choice.LeftJoke), bucket(choice.RightJoke)
+		if !ok || x == y {
+			continue
+		}
+		comparisons = append(comparisons, Comparison{X: x, Y: y, Outcome: outcome})
+	}
+	if len(comparisons) != ref.Comparisons {
+		t.Fatalf("got %d comparisons, the reference has %d", len(comparisons), ref.Comparisons)
+	}
+	return &ref, comparisons
+}
+
+func compareScores(t *testing.T, name string, got, want Scores, tolerance float64) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Errorf("%s: got %d players, want %d", name, len(got), len(want))
+	}
+	for player, score := range want {
+		if diff := math.Abs(got[player] - score); !(diff <= tolerance) {
+			t.Errorf("%s: score of %s = %v, want %v ± %v", name, player, got[player], score, tolerance)
+		}
+	}
+}
+
+func TestReference(t *testing.T) {
+	ref, comparisons := loadReference(t)
+	t.Logf("reference scores from %s", ref.Generator)
+	opts := DefaultSolverOptions()
+
+	t.Run("Elo", func(t *testing.T) {
+		// A single deterministic pass, so only rounding differs.
+		compareScores(t, "Elo", Elo(comparisons, DefaultEloOptions()), ref.Elo, 1e-9)
+	})
+	t.Run("BradleyTerry", func(t *testing.T) {
+		// Scores sum to one; both stop once no score moves by more than the
+		// solver tolerance.
+		compareScores(t, "BradleyTerry", BradleyTerry(comparisons, opts), ref.BradleyTerry, 10*opts.Tolerance)
+	})
+	t.Run("Newman", func(t *testing.T) {
+		scores, v := Newman(comparisons, 0.5, opts)
+		// Scores are around one and converge to the solver tolerance.
+		compareScores(t, "Newman", scores, ref.Newman, 1e-4)
+		if diff := math.Abs(v - ref.NewmanV); !(diff <= 1e-4) {
+			t.Errorf("tie parameter = %v, want %v ± 1e-4", v, ref.NewmanV)
+		}
+	})
+}
+
+func TestNewmanRecoversTieRate(t *testing.T) {
+	// Two equally strong players tie with probability v/(1+v), so 20 ties in
+	// 100 comparisons give v = 0.25.
+	var comparisons []Comparison
+	for i := range 100 {
+		outcome := Draw
+		switch {
+		case i < 40:
+			outcome = X
+		case i < 80:
+			outcome = Y
+		}
+		comparisons = append(comparisons, Comparison{X: "a", Y: "b", Outcome: outcome})
+	}
+	scores, v := Newman(comparisons, 0.5, DefaultSolverOptions())
+	if math.Abs(v-0.25) > 1e-4 {
+		t.Errorf("tie parameter = %v, want 0.25", v)
+	}
+	if math.Abs(scores["a"]-scores["b"]) > 1e-6 {
+		t.Errorf("scores of equally strong players differ: %v", scores)
+	}
+}
@@ -0,0 +1,90 @@
+package ratings
+
+// SparseBradleyTerry computes Bradley-Terry scores for many players with few
+// comparisons each, such as individual jokes, where the dense matrices of
+// BradleyTerry do not fit in memory.
+//
+// Every player also gets prior wins and prior losses against a virtual
+// player with a fixed score of one. This keeps the scores of players that
+// never lost (or never won) finite and pulls players with few comparisons
+// towards the average. Scores are relative to the virtual player and are not
+// normalized. A draw counts as half a win for both players.
+func SparseBradleyTerry(comparisons []Comparison, prior float64, opts SolverOptions) Scores {
+	idx := newIndex(comparisons)
+	n := len(idx.players)
+
+	type pair struct {
+		i, j int
+	}
+	// games[{i, j}] with i < j counts comparisons between i and j.
+	games := make(map[pair]float64)
+	totalWins := make([]float64, n)
+	for _, c := range comparisons {
+		i, j := idx.ids[c.X], idx.ids[c.Y]
+		if i == j {
+			continue
+		}
+		switch c.Outcome {
+		case X:
+			totalWins[i]++
+		case Y:
+			totalWins[j]++
+		case Draw:
+			totalWins[i] += 0.5
+			totalWins[j] += 0.5
+		}
+		if j < i {
+			i, j = j, i
+		}
+		games[pair{i, j}]++
+	}
+
+	type edge struct {
+		i, j  int
+		games float64
+	}
+	edges := make([]edge, 0, len(games))
+	for p, g := range games {
+		edges = append(edges, edge{p.i, p.j, g})
+	}
+
+	scores := make([]float64, n)
+	for i := range scores {
+		scores[i] = 1
+	}
+	next := make([]float64, n)
+	denominators := make([]float64, n)
+
+	for iteration := 0; iteration < opts.Limit; iteration++ {
+		for i := range denominators {
+			denominators[i] = 2 * prior / (scores[i] + 1)
+		}
+		for _, e := range edges {
+			d := e.games / (scores[e.i] + scores[e.j])
+			denominators[e.i] += d
+			denominators[e.j] += d
+		}
+		for i := range next {
+			if denominators[i] == 0 {
+				next[i] = scores[i]
+				continue
+			}
+			next[i] = (totalWins[i] + prior) / denominators[i]
+		}
+
+		converged := maxAbsDiff(next, scores) <= opts.Tolerance
+		scores, next = next, scores
+		if converged {
+			break
+		}
+	}
+
+	return idx.scores(scores)
+}
+
+// SparseBradleyTerrySystem binds the prior and options to SparseBradleyTerry.
+func SparseBradleyTerrySystem(prior float64, opts SolverOptions) System {
+	return func(comparisons []Comparison) Scores {
+		return SparseBradleyTerry(comparisons, prior, opts)
+	}
+}
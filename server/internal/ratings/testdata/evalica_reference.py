@@ -0,0 +1,92 @@
+#!/usr/bin/env python
+"""
+Generates evalica_reference.json, the expected scores of ratings_test.go.
+
+The dataset dump has no model names and almost every joke is compared only
+once or twice, so jokes are grouped into BUCKETS players by the first byte of
+the SHA-256 of their text. This gives a small, densely connected set of
+players on which Bradley-Terry and Newman are well defined. Comparisons
+within a bucket and votes without a preference are dropped.
+
+Scores come from evalica, so ratings_test.go checks the Go implementations
+against it. The test is skipped until the output is committed:
+
+    pip install evalica
+    python server/internal/ratings/testdata/evalica_reference.py
+"""
+
+import hashlib
+import json
+from pathlib import Path
+
+import evalica
+
+ROOT = Path(__file__).resolve().parents[4]
+DATASET = ROOT / "data" / "humor_arena.choices.20250812.json"
+OUTPUT = Path(__file__).resolve().parent / "evalica_reference.json"
+
+BUCKETS = 8
+# The solver settings of ratings.DefaultSolverOptions and the initial tie
+# parameter of the leaderboard.
+TOLERANCE = 1e-6
+LIMIT = 1000
+NEWMAN_V_INIT = 0.5
+
+X, Y, DRAW = "x", "y", "draw"
+OUTCOMES = {"LEFT": X, "RIGHT": Y, "BOTH": DRAW}
+
+
+def bucket(text: str) -> str:
+    return f"b{hashlib.sha256(text.encode()).digest()[0] % BUCKETS}"
+
+
+def load() -> tuple[list[str], list[str], list[str]]:
+    xs, ys, outcomes = [], [], []
+    for choice in json.loads(DATASET.read_text()):
+        outcome = OUTCOMES.get(choice["winner"])
+        x, y = bucket(choice["left_joke"]), bucket(choice["right_joke"])
+        if outcome is None or x == y:
+            continue
+        xs.append(x)
+        ys.append(y)
+        outcomes.append(outcome)
+    return xs, ys, outcomes
+
+
+def scores(xs, ys, outcomes):
+    winners = {X: evalica.Winner.X, Y: evalica.Winner.Y, DRAW: evalica.Winner.Draw}
+    ws = [winners[o] for o in outcomes]
+    bt = evalica.bradley_terry(xs, ys, ws, tolerance=TOLERANCE, limit=LIMIT)
+    nm = evalica.newman(xs, ys, ws, v_init=NEWMAN_V_INIT, tolerance=TOLERANCE, limit=LIMIT)
+    el = evalica.elo(xs, ys, ws)
+    return (
+        f"evalica {evalica.__version__}",
+        dict(el.scores),
+        dict(bt.scores),
+        dict(nm.scores),
+        float(nm.v),
+    )
+
+
+def main() -> None:
+    xs, ys, outcomes = load()
+    generator, elo_scores, bt_scores, newman_scores, v = scores(xs, ys, outcomes)
+
+    def ordered(values):
+        return {p: float(values[p]) for p in sorted(values)}
+
+    reference = {
+        "generator": generator,
+        "dataset": DATASET.name,
+        "buckets": BUCKETS,
+        "comparisons": len(outcomes),
+        "elo": ordered(elo_scores),
+        "bradley_terry": ordered(bt_scores),
+        "newman": ordered(newman_scores),
+        "newman_v": v,
+    }
+    OUTPUT.write_text(json.dumps(reference, indent=2) + "\n")
+
+
+if __name__ == "__main__":
+    main()
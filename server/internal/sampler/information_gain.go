@@ -0,0 +1,181 @@
+package sampler
+
+import (
+	"context"
+	"fmt"
+	"math"
+	insecureRand "math/rand/v2"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+	"gonum.org/v1/gonum/stat/sampleuv"
+
+	"github.com/SaveTheRbtz/humor/server/internal/metrics"
+	"github.com/SaveTheRbtz/humor/server/internal/storage"
+)
+
+const (
+	// ciZ is the normal quantile of the 95% bootstrap intervals stored by the
+	// leaderboard job.
+	ciZ = 1.959964
+	// priorSD is the log-strength uncertainty of models that are not on the
+	// leaderboard yet, wide enough to make them a priority.
+	priorSD = 1.0
+)
+
+// posterior is a normal approximation of a model's log Bradley-Terry
+// strength.
+type posterior struct {
+	mean, sd float64
+}
+
+type posteriorsCache struct {
+	posteriors map[string]posterior
+	// prior is used for models missing from posteriors.
+	prior     posterior
+	timestamp time.Time
+}
+
+// informationGain picks model pairs with probability proportional to the
+// expected information a vote carries about which model is better, under the
+// Bradley-Terry posterior of the latest leaderboard. Votes go to pairs whose
+// intervals still overlap, while pairs with a settled order get almost none.
+// Themes and jokes within a model are picked uniformly.
+type informationGain struct {
+	*uniform
+
+	logger   *zap.Logger
+	cacheTTL time.Duration
+	cache    atomic.Pointer[posteriorsCache]
+}
+
+func newInformationGain(store storage.Storage, logger *zap.Logger, cacheTTL time.Duration) *informationGain {
+	return &informationGain{
+		uniform:  newUniform(store),
+		logger:   logger,
+		cacheTTL: cacheTTL,
+	}
+}
+
+func (s *informationGain) Name() string {
+	return InformationGain
+}
+
+// getPosteriors derives posteriors from the Newman scores of the latest
+// leaderboard, cached for the cache TTL.
+func (s *informationGain) getPosteriors(ctx context.Context) (*posteriorsCache, error) {
+	cached := s.cache.Load()
+	hit := cached != nil && time.Since(cached.timestamp) < s.cacheTTL
+	metrics.CacheLookup("posteriors", hit)
+	if hit {
+		return cached, nil
+	}
+
+	board, err := s.store.GetLatestLeaderboard(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get leaderboard: %w", err)
+	}
+
+	cached = &posteriorsCache{
+		posteriors: make(map[string]posterior, len(board.Entries)),
+		prior:      posterior{sd: priorSD},
+		timestamp:  time.Now(),
+	}
+	var sum float64
+	for _, entry := range board.Entries {
+		lower := entry.NewmanScore - entry.NewmanCiLower
+		upper := entry.NewmanScore + entry.NewmanCiUpper
+		if entry.NewmanScore <= 0 || lower <= 0 {
+			continue
+		}
+		p := posterior{
+			mean: math.Log(entry.NewmanScore),
+			sd:   (math.Log(upper) - math.Log(lower)) / (2 * ciZ),
+		}
+		cached.posteriors[entry.Model] = p
+		sum += p.mean
+	}
+	if len(cached.posteriors) > 0 {
+		cached.prior.mean = sum / float64(len(cached.posteriors))
+	}
+	s.cache.Store(cached)
+
+	return cached, nil
+}
+
+func (c *posteriorsCache) get(model string) posterior {
+	if p, ok := c.posteriors[model]; ok {
+		return p
+	}
+	return c.prior
+}
+
+// binaryEntropy returns the entropy of a Bernoulli(p) variable in bits.
+func binaryEntropy(p float64) float64 {
+	if p <= 0 || p >= 1 {
+		return 0
+	}
+	return -p*math.Log2(p) - (1-p)*math.Log2(1-p)
+}
+
+// pairInformationGain returns the mutual information, in bits, between the
+// outcome of a vote and the strengths of two models (BALD, Houlsby et al.
+// 2011). The logistic link is approximated by a probit one.
+func pairInformationGain(a, b posterior) float64 {
+	// sigmoid(x) ~ Phi(x * sqrt(pi / 8))
+	scale := math.Sqrt(math.Pi / 8)
+	mean := (a.mean - b.mean) * scale
+	variance := (a.sd*a.sd + b.sd*b.sd) * scale * scale
+
+	c2 := math.Pi * math.Ln2 / 2
+	phi := 0.5 * math.Erfc(-mean/math.Sqrt(2*(1+variance)))
+	expectedEntropy := math.Sqrt(c2/(variance+c2)) * math.Exp(-mean*mean/(2*(variance+c2)))
+	return max(0, binaryEntropy(phi)-expectedEntropy)
+}
+
+func (s *informationGain) SamplePair(ctx context.Context, jokes []storage.Joke) (storage.Joke, storage.Joke, error) {
+	if len(jokes) < 2 {
+		return storage.Joke{}, storage.Joke{}, fmt.Errorf("not enough jokes: %d", len(jokes))
+	}
+
+	posteriors, err := s.getPosteriors(ctx)
+	if err != nil {
+		s.logger.Warn("SamplePair failed to get posteriors", zap.Error(err))
+		metrics.SamplerFallbacks.WithLabelValues(InformationGain, "posteriors").Inc()
+		return s.uniform.SamplePair(ctx, jokes)
+	}
+
+	jokesByModel := make(map[string][]storage.Joke)
+	for _, joke := range jokes {
+		jokesByModel[joke.Model] = append(jokesByModel[joke.Model], joke)
+	}
+	models := make([]string, 0, len(jokesByModel))
+	for model := range jokesByModel {
+		models = append(models, model)
+	}
+	sort.Strings(models)
+
+	type modelPair struct {
+		left, right string
+	}
+	pairs := make([]modelPair, 0, len(models)*(len(models)-1)/2)
+	weights := make([]float64, 0, cap(pairs))
+	for i, left := range models {
+		for _, right := range models[i+1:] {
+			pairs = append(pairs, modelPair{left, right})
+			weights = append(weights, pairInformationGain(posteriors.get(left), posteriors.get(right)))
+		}
+	}
+
+	// A nil source uses the global, goroutine-safe generator.
+	i, ok := sampleuv.NewWeighted(weights, nil).Take()
+	if !ok {
+		s.logger.Debug("SamplePair found no informative model pair", zap.Strings("models", models))
+		return s.uniform.SamplePair(ctx, jokes)
+	}
+	pair := pairs[i]
+	leftJokes, rightJokes := jokesByModel[pair.left], jokesByModel[pair.right]
+	return leftJokes[insecureRand.IntN(len(leftJokes))], rightJokes[insecureRand.IntN(len(rightJokes))], nil
+}
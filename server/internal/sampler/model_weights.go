@@ -0,0 +1,128 @@
+package sampler
+
+import (
+	"context"
+	"fmt"
+	insecureRand "math/rand/v2"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+	"gonum.org/v1/gonum/stat/sampleuv"
+
+	"github.com/SaveTheRbtz/humor/server/internal/metrics"
+	"github.com/SaveTheRbtz/humor/server/internal/storage"
+)
+
+type modelWeightsCache struct {
+	models    []string
+	matrix    [][]float64
+	timestamp time.Time
+}
+
+// modelWeights picks the left joke uniformly and the model of the right joke
+// from the left model's row of the latest model weights, which prefer model
+// pairs with fewer votes.
+type modelWeights struct {
+	*uniform
+
+	logger   *zap.Logger
+	cacheTTL time.Duration
+	cache    atomic.Pointer[modelWeightsCache]
+}
+
+func newModelWeights(store storage.Storage, logger *zap.Logger, cacheTTL time.Duration) *modelWeights {
+	return &modelWeights{
+		uniform:  newUniform(store),
+		logger:   logger,
+		cacheTTL: cacheTTL,
+	}
+}
+
+func (s *modelWeights) Name() string {
+	return ModelWeights
+}
+
+func (s *modelWeights) getAllModelWeights(ctx context.Context) (*modelWeightsCache, error) {
+	cached := s.cache.Load()
+	hit := cached != nil && time.Since(cached.timestamp) < s.cacheTTL
+	metrics.CacheLookup("model_weights", hit)
+	if hit {
+		return cached, nil
+	}
+
+	modelWeights, err := s.store.GetLatestModelWeights(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get model weights: %w", err)
+	}
+
+	modelWeightsMatrix, err := modelWeights.Matrix()
+	if err != nil {
+		return nil, fmt.Errorf("failed to reshape model weights: %w", err)
+	}
+
+	cached = &modelWeightsCache{
+		models:    modelWeights.Models,
+		matrix:    modelWeightsMatrix,
+		timestamp: time.Now(),
+	}
+	s.cache.Store(cached)
+
+	return cached, nil
+}
+
+func (s *modelWeights) getJokesForModel(ctx context.Context, theme, model string) ([]storage.Joke, error) {
+	modelWeights, err := s.getAllModelWeights(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get all model weights: %w", err)
+	}
+
+	leftModelWeights := make([]float64, 0)
+	foundModelID := -1
+	for modedID, m := range modelWeights.models {
+		if m == model {
+			leftModelWeights = modelWeights.matrix[modedID]
+			foundModelID = modedID
+			break
+		}
+	}
+	if foundModelID == -1 {
+		return nil, fmt.Errorf("model not found in model weights: %s, %v", model, modelWeights.models)
+	}
+
+	// A nil source uses the global, goroutine-safe generator.
+	rightModelIdx, ok := sampleuv.NewWeighted(leftModelWeights, nil).Take()
+	if !ok {
+		return nil, fmt.Errorf("failed to sample model: %s", model)
+	}
+	rightModel := modelWeights.models[rightModelIdx]
+
+	filteredJokes, err := s.store.GetActiveJokesByThemeAndModel(ctx, theme, rightModel)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get jokes for model %s: %w", rightModel, err)
+	}
+	if len(filteredJokes) < 1 {
+		return nil, fmt.Errorf("no jokes found for model: %s", rightModel)
+	}
+
+	s.logger.Debug("Filtered jokes count", zap.Int("count", len(filteredJokes)))
+
+	return filteredJokes, nil
+}
+
+func (s *modelWeights) SamplePair(ctx context.Context, jokes []storage.Joke) (storage.Joke, storage.Joke, error) {
+	if len(jokes) < 2 {
+		return storage.Joke{}, storage.Joke{}, fmt.Errorf("not enough jokes: %d", len(jokes))
+	}
+	left := insecureRand.IntN(len(jokes))
+	leftJoke := jokes[left]
+
+	filteredJokes, err := s.getJokesForModel(ctx, leftJoke.Theme, leftJoke.Model)
+	if err != nil {
+		s.logger.Warn("SamplePair failed to get jokes for model", zap.String("model", leftJoke.Model), zap.String("theme", leftJoke.Theme), zap.Error(err))
+		metrics.SamplerFallbacks.WithLabelValues(ModelWeights, "model_jokes").Inc()
+		return leftJoke, otherJoke(jokes, left), nil
+	}
+
+	return leftJoke, filteredJokes[insecureRand.IntN(len(filteredJokes))], nil
+}
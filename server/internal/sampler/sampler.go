@@ -0,0 +1,51 @@
+// Package sampler implements the strategies that pick which jokes users vote
+// on next.
+package sampler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/SaveTheRbtz/humor/server/internal/storage"
+)
+
+// Names of the available samplers.
+const (
+	Uniform         = "uniform"
+	ModelWeights    = "model-weights"
+	ThemeBalanced   = "theme-balanced"
+	InformationGain = "information-gain"
+)
+
+// Names lists the available samplers.
+var Names = []string{Uniform, ModelWeights, ThemeBalanced, InformationGain}
+
+// Sampler picks the theme and the pair of jokes of a new choice.
+type Sampler interface {
+	// Name is recorded in every choice made with the sampler.
+	Name() string
+	// SampleTheme picks a random active theme.
+	SampleTheme(ctx context.Context) (*storage.Theme, error)
+	// SamplePair picks two different jokes among the active jokes of a
+	// theme. The caller decides on which side each joke is shown.
+	SamplePair(ctx context.Context, jokes []storage.Joke) (first, second storage.Joke, err error)
+}
+
+// New returns the sampler with the given name.
+func New(name string, store storage.Storage, logger *zap.Logger, cacheTTL time.Duration) (Sampler, error) {
+	switch name {
+	case Uniform:
+		return newUniform(store), nil
+	case ModelWeights:
+		return newModelWeights(store, logger, cacheTTL), nil
+	case ThemeBalanced:
+		return newThemeBalanced(store, logger, cacheTTL), nil
+	case InformationGain:
+		return newInformationGain(store, logger, cacheTTL), nil
+	}
+	return nil, fmt.Errorf("unknown sampler %q, expected one of: %s", name, strings.Join(Names, ", "))
+}
@@ -0,0 +1,110 @@
+package sampler
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/SaveTheRbtz/humor/server/internal/metrics"
+	"github.com/SaveTheRbtz/humor/server/internal/storage"
+)
+
+// themeCandidates is how many random themes themeBalanced compares.
+const themeCandidates = 4
+
+type themeVotesCache struct {
+	votes     map[string]int
+	timestamp time.Time
+}
+
+// themeBalanced draws a few random themes and picks the one with the fewest
+// votes, which evens out votes across themes over time. Jokes are picked
+// uniformly.
+type themeBalanced struct {
+	*uniform
+
+	logger   *zap.Logger
+	cacheTTL time.Duration
+	group    singleflight.Group
+	cache    atomic.Pointer[themeVotesCache]
+}
+
+func newThemeBalanced(store storage.Storage, logger *zap.Logger, cacheTTL time.Duration) *themeBalanced {
+	return &themeBalanced{
+		uniform:  newUniform(store),
+		logger:   logger,
+		cacheTTL: cacheTTL,
+	}
+}
+
+func (s *themeBalanced) Name() string {
+	return ThemeBalanced
+}
+
+// getThemeVotes returns the number of votes per theme ID, cached for the cache TTL.
+func (s *themeBalanced) getThemeVotes(ctx context.Context) (map[string]int, error) {
+	cached := s.cache.Load()
+	hit := cached != nil && time.Since(cached.timestamp) < s.cacheTTL
+	metrics.CacheLookup("theme_votes", hit)
+	if hit {
+		return cached.votes, nil
+	}
+
+	votes, err, _ := s.group.Do("", func() (any, error) {
+		choices, err := s.store.ListRatedChoices(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get choices: %w", err)
+		}
+		votes := make(map[string]int)
+		for _, choice := range choices {
+			votes[choice.ThemeID]++
+		}
+		s.cache.Store(&themeVotesCache{
+			votes:     votes,
+			timestamp: time.Now(),
+		})
+		return votes, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return votes.(map[string]int), nil
+}
+
+func (s *themeBalanced) SampleTheme(ctx context.Context) (*storage.Theme, error) {
+	var themes []storage.Theme
+	var err error
+	// There may be fewer active themes than candidates, down to a single one
+	// that needs no comparison.
+	for candidates := themeCandidates; candidates > 0; candidates-- {
+		themes, err = s.store.GetRandomThemes(ctx, candidates)
+		if err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get random theme: %w", err)
+	}
+	if len(themes) == 1 {
+		return &themes[0], nil
+	}
+
+	votes, err := s.getThemeVotes(ctx)
+	if err != nil {
+		s.logger.Warn("SampleTheme failed to get theme votes", zap.Error(err))
+		metrics.SamplerFallbacks.WithLabelValues(ThemeBalanced, "theme_votes").Inc()
+		return &themes[0], nil
+	}
+
+	best := 0
+	for i := range themes {
+		if votes[themes[i].ID] < votes[themes[best].ID] {
+			best = i
+		}
+	}
+	return &themes[best], nil
+}
@@ -0,0 +1,48 @@
+package sampler
+
+import (
+	"context"
+	"fmt"
+	insecureRand "math/rand/v2"
+
+	"github.com/SaveTheRbtz/humor/server/internal/storage"
+)
+
+// uniform picks themes and jokes uniformly at random. Other samplers embed
+// it for the parts they do not change.
+type uniform struct {
+	store storage.Storage
+}
+
+func newUniform(store storage.Storage) *uniform {
+	return &uniform{store: store}
+}
+
+func (s *uniform) Name() string {
+	return Uniform
+}
+
+func (s *uniform) SampleTheme(ctx context.Context) (*storage.Theme, error) {
+	themes, err := s.store.GetRandomThemes(ctx, 1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get random theme: %w", err)
+	}
+	return &themes[0], nil
+}
+
+func (s *uniform) SamplePair(ctx context.Context, jokes []storage.Joke) (storage.Joke, storage.Joke, error) {
+	if len(jokes) < 2 {
+		return storage.Joke{}, storage.Joke{}, fmt.Errorf("not enough jokes: %d", len(jokes))
+	}
+	left := insecureRand.IntN(len(jokes))
+	return jokes[left], otherJoke(jokes, left), nil
+}
+
+// otherJoke returns a random joke other than jokes[skip].
+func otherJoke(jokes []storage.Joke, skip int) storage.Joke {
+	i := insecureRand.IntN(len(jokes) - 1)
+	if i >= skip {
+		i++
+	}
+	return jokes[i]
+}
@@ -0,0 +1,130 @@
+package server
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+
+	"github.com/SaveTheRbtz/humor/server/internal/metrics"
+)
+
+// maxFilterCacheEntries bounds the number of filters a filterCache keeps.
+// Filters are quantized and validated, but their combinations are still
+// chosen by clients.
+const maxFilterCacheEntries = 64
+
+// filterCacheComputeTimeout bounds a computation shared by concurrent misses.
+// It runs detached from the requests waiting for it, so one caller going away
+// does not fail the others.
+const filterCacheComputeTimeout = time.Minute
+
+type filterCacheEntry[K comparable, T any] struct {
+	filter    K
+	value     T
+	timestamp time.Time
+}
+
+// filterCache memoizes values computed from the votes that pass a filter,
+// usually a leaderboard.Filter. Concurrent misses for the same filter share a
+// single computation. At most maxEntries filters are kept; the least recently
+// used one is evicted first.
+type filterCache[K comparable, T any] struct {
+	// name labels the cache in metrics.
+	name string
+	// ttl is how long results computed for a filter are reused.
+	ttl        time.Duration
+	maxEntries int
+	group      singleflight.Group
+	mu         sync.Mutex
+	// lru holds *filterCacheEntry values, most recently used first.
+	lru     *list.List
+	entries map[K]*list.Element
+}
+
+func newFilterCache[K comparable, T any](name string, ttl time.Duration, maxEntries int) *filterCache[K, T] {
+	return &filterCache[K, T]{
+		name:       name,
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		lru:        list.New(),
+		entries:    make(map[K]*list.Element),
+	}
+}
+
+func (c *filterCache[K, T]) lookup(filter K) (T, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	element, ok := c.entries[filter]
+	if !ok {
+		var zero T
+		return zero, false
+	}
+	entry := element.Value.(*filterCacheEntry[K, T])
+	if time.Since(entry.timestamp) >= c.ttl {
+		c.lru.Remove(element)
+		delete(c.entries, filter)
+		var zero T
+		return zero, false
+	}
+	c.lru.MoveToFront(element)
+	return entry.value, true
+}
+
+func (c *filterCache[K, T]) store(filter K, value T) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if element, ok := c.entries[filter]; ok {
+		c.lru.Remove(element)
+	}
+	c.entries[filter] = c.lru.PushFront(&filterCacheEntry[K, T]{
+		filter:    filter,
+		value:     value,
+		timestamp: time.Now(),
+	})
+	for c.lru.Len() > c.maxEntries {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*filterCacheEntry[K, T]).filter)
+	}
+}
+
+// get returns the cached value for the filter or computes it. The
+// computation gets a context that keeps the values of ctx but not its
+// cancellation; callers stop waiting when their own ctx is done.
+func (c *filterCache[K, T]) get(
+	ctx context.Context,
+	filter K,
+	compute func(ctx context.Context) (T, error),
+) (T, error) {
+	var zero T
+	value, hit := c.lookup(filter)
+	metrics.CacheLookup(c.name, hit)
+	if hit {
+		return value, nil
+	}
+
+	key := fmt.Sprintf("%+v", filter)
+	results := c.group.DoChan(key, func() (any, error) {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), filterCacheComputeTimeout)
+		defer cancel()
+		value, err := compute(ctx)
+		if err != nil {
+			return nil, err
+		}
+		c.store(filter, value)
+		return value, nil
+	})
+	select {
+	case <-ctx.Done():
+		return zero, ctx.Err()
+	case result := <-results:
+		if result.Err != nil {
+			return zero, result.Err
+		}
+		return result.Val.(T), nil
+	}
+}
@@ -0,0 +1,109 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestFilterCache(t *testing.T) {
+	ctx := context.Background()
+	cache := newFilterCache[int, int]("test", time.Hour, 2)
+	computed := 0
+	get := func(filter int) int {
+		t.Helper()
+		value, err := cache.get(ctx, filter, func(context.Context) (int, error) {
+			computed++
+			return filter * 10, nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return value
+	}
+
+	if get(1) != 10 || get(1) != 10 || computed != 1 {
+		t.Fatalf("a hit recomputed the value: %d computations", computed)
+	}
+	get(2)
+	get(1) // 2 is now the least recently used filter.
+	get(3)
+	if len(cache.entries) != 2 || cache.lru.Len() != 2 {
+		t.Fatalf("cache holds %d entries, want 2", len(cache.entries))
+	}
+	computed = 0
+	get(1)
+	get(3)
+	if computed != 0 {
+		t.Errorf("recently used filters were evicted")
+	}
+	get(2)
+	if computed != 1 {
+		t.Errorf("the least recently used filter was kept")
+	}
+
+	if _, err := cache.get(ctx, 4, func(context.Context) (int, error) { return 0, errors.New("failed") }); err == nil {
+		t.Error("error was not returned")
+	}
+	if _, ok := cache.entries[4]; ok {
+		t.Error("failed computation was cached")
+	}
+}
+
+func TestFilterCacheExpiry(t *testing.T) {
+	ctx := context.Background()
+	cache := newFilterCache[int, int]("test", time.Nanosecond, 2)
+	computed := 0
+	for range 2 {
+		if _, err := cache.get(ctx, 1, func(context.Context) (int, error) { computed++; return 1, nil }); err != nil {
+			t.Fatal(err)
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if computed != 2 {
+		t.Errorf("expired value was reused")
+	}
+}
+
+func TestFilterCacheDetachedCompute(t *testing.T) {
+	cache := newFilterCache[int, int]("test", time.Hour, 2)
+	started, release := make(chan struct{}), make(chan struct{})
+	computeErr := make(chan error, 1)
+	compute := func(ctx context.Context) (int, error) {
+		close(started)
+		<-release
+		computeErr <- ctx.Err()
+		return 1, nil
+	}
+
+	// The first caller gives up while the computation is running.
+	first, cancel := context.WithCancel(context.Background())
+	firstErr := make(chan error, 1)
+	go func() {
+		_, err := cache.get(first, 1, compute)
+		firstErr <- err
+	}()
+	<-started
+	cancel()
+	if err := <-firstErr; !errors.Is(err, context.Canceled) {
+		t.Fatalf("canceled caller got %v, want context.Canceled", err)
+	}
+
+	// A second caller shares the computation and still gets its value.
+	secondValue := make(chan int, 1)
+	go func() {
+		value, err := cache.get(context.Background(), 1, compute)
+		if err != nil {
+			t.Error(err)
+		}
+		secondValue <- value
+	}()
+	close(release)
+	if err := <-computeErr; err != nil {
+		t.Errorf("computation context: %v, want no error", err)
+	}
+	if value := <-secondValue; value != 1 {
+		t.Errorf("second caller got %d, want 1", value)
+	}
+}
@@ -0,0 +1,157 @@
+package server
+
+import (
+	"context"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	choicesv1 "github.com/SaveTheRbtz/humor/gen/go/proto"
+	"github.com/SaveTheRbtz/humor/server/internal/leaderboard"
+)
+
+const (
+	defaultHeadToHeadExamples = 3
+	maxHeadToHeadExamples     = 10
+)
+
+// getHeadToHeads counts the votes between every pair of models that pass the
+// filter. Results are cached per filter for the cache TTL, and the votes are
+// shared with the other filtered RPCs.
+func (s *Server) getHeadToHeads(ctx context.Context, filter leaderboard.Filter) ([]leaderboard.HeadToHead, error) {
+	return s.headToHeadCache.get(ctx, filter, func(ctx context.Context) ([]leaderboard.HeadToHead, error) {
+		v, err := s.listVotes(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return leaderboard.HeadToHeads(v.choices, v.jokes, filter, maxHeadToHeadExamples), nil
+	})
+}
+
+func headToHeadExamples(maxExamples uint32) int {
+	if maxExamples == 0 {
+		return defaultHeadToHeadExamples
+	}
+	return min(int(maxExamples), maxHeadToHeadExamples)
+}
+
+func headToHeadRecord(record leaderboard.Record) *choicesv1.HeadToHeadRecord {
+	return &choicesv1.HeadToHeadRecord{
+		Votes:   uint64(record.Votes()),
+		Wins:    uint64(record.Wins),
+		Losses:  uint64(record.Losses),
+		Ties:    uint64(record.Ties),
+		BothBad: uint64(record.BothBad),
+		WinRate: record.WinRate(),
+	}
+}
+
+func headToHead(h *leaderboard.HeadToHead, maxExamples int) *choicesv1.HeadToHead {
+	resp := &choicesv1.HeadToHead{
+		ModelA: h.ModelA,
+		ModelB: h.ModelB,
+		Record: headToHeadRecord(h.Record),
+		Themes: make([]*choicesv1.ThemeHeadToHead, 0, len(h.Themes)),
+	}
+	for _, theme := range h.Themes {
+		resp.Themes = append(resp.Themes, &choicesv1.ThemeHeadToHead{
+			ThemeId: theme.ThemeID,
+			Theme:   theme.Theme,
+			Record:  headToHeadRecord(theme.Record),
+		})
+	}
+	examples := h.Examples
+	if len(examples) > maxExamples {
+		examples = examples[:maxExamples]
+	}
+	for _, example := range examples {
+		resp.Examples = append(resp.Examples, &choicesv1.HeadToHeadExample{
+			ThemeId: example.ThemeID,
+			Theme:   example.Theme,
+			JokeA:   example.JokeA,
+			JokeB:   example.JokeB,
+			Winner:  example.Winner,
+			RatedAt: timestamppb.New(example.RatedAt),
+		})
+	}
+	return resp
+}
+
+func (s *Server) GetHeadToHead(
+	ctx context.Context,
+	req *choicesv1.GetHeadToHeadRequest,
+) (*choicesv1.GetHeadToHeadResponse, error) {
+	if req.ModelA == "" || req.ModelB == "" {
+		return nil, status.Error(codes.InvalidArgument, "Both models are required")
+	}
+	if req.ModelA == req.ModelB {
+		return nil, status.Error(codes.InvalidArgument, "Models must be different")
+	}
+	filter, err := s.newFilter(ctx, req.ThemeSet, req.Policy, req.StartTime, req.EndTime, req.ExcludeKnown)
+	if err != nil {
+		return nil, err
+	}
+
+	pairs, err := s.getHeadToHeads(ctx, filter)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get head-to-head: %v", err)
+	}
+
+	// Pairs are stored once, with model_a sorted before model_b.
+	modelA, modelB := req.ModelA, req.ModelB
+	swap := modelB < modelA
+	if swap {
+		modelA, modelB = modelB, modelA
+	}
+	i := sort.Search(len(pairs), func(i int) bool {
+		if pairs[i].ModelA != modelA {
+			return pairs[i].ModelA > modelA
+		}
+		return pairs[i].ModelB >= modelB
+	})
+	if i == len(pairs) || pairs[i].ModelA != modelA || pairs[i].ModelB != modelB {
+		return nil, status.Errorf(codes.NotFound, "No votes between %s and %s", req.ModelA, req.ModelB)
+	}
+	pair := pairs[i]
+	if swap {
+		pair = pair.Swap()
+	}
+
+	return &choicesv1.GetHeadToHeadResponse{
+		HeadToHead: headToHead(&pair, headToHeadExamples(req.MaxExamples)),
+	}, nil
+}
+
+func (s *Server) GetHeadToHeadMatrix(
+	ctx context.Context,
+	req *choicesv1.GetHeadToHeadMatrixRequest,
+) (*choicesv1.GetHeadToHeadMatrixResponse, error) {
+	filter, err := s.newFilter(ctx, req.ThemeSet, req.Policy, req.StartTime, req.EndTime, req.ExcludeKnown)
+	if err != nil {
+		return nil, err
+	}
+
+	pairs, err := s.getHeadToHeads(ctx, filter)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get head-to-head matrix: %v", err)
+	}
+
+	maxExamples := headToHeadExamples(req.MaxExamples)
+	resp := &choicesv1.GetHeadToHeadMatrixResponse{
+		Pairs: make([]*choicesv1.HeadToHead, 0, len(pairs)),
+	}
+	models := make(map[string]struct{})
+	for i := range pairs {
+		models[pairs[i].ModelA] = struct{}{}
+		models[pairs[i].ModelB] = struct{}{}
+		resp.Pairs = append(resp.Pairs, headToHead(&pairs[i], maxExamples))
+	}
+	for model := range models {
+		resp.Models = append(resp.Models, model)
+	}
+	sort.Strings(resp.Models)
+
+	return resp, nil
+}
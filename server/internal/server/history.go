@@ -0,0 +1,271 @@
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	choicesv1 "github.com/SaveTheRbtz/humor/gen/go/proto"
+	"github.com/SaveTheRbtz/humor/server/internal/storage"
+)
+
+const (
+	defaultSnapshotsPageSize = 100
+	maxSnapshotsPageSize     = 1000
+	// maxModelHistorySnapshots bounds the snapshots read by GetModelHistory;
+	// longer ranges are cut to their most recent snapshots.
+	maxModelHistorySnapshots = 1000
+)
+
+// encodeSnapshotsPageToken returns an opaque token that continues listing
+// after the snapshot: with older snapshots and with those created at the
+// same time that have a smaller ID.
+func encodeSnapshotsPageToken(board *storage.Leaderboard) string {
+	raw := strconv.FormatInt(board.CreatedAt.UnixNano(), 10) + "." + board.ID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeSnapshotsPageToken(token string) (*storage.LeaderboardCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, err
+	}
+	createdAt, id, ok := strings.Cut(string(raw), ".")
+	if !ok || id == "" {
+		return nil, errors.New("missing snapshot ID")
+	}
+	ns, err := strconv.ParseInt(createdAt, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &storage.LeaderboardCursor{CreatedAt: time.Unix(0, ns), ID: id}, nil
+}
+
+// timeRange converts optional request timestamps into a [start, end) range.
+func timeRange(startTime, endTime *timestamppb.Timestamp) (time.Time, time.Time, error) {
+	var start, end time.Time
+	if startTime != nil {
+		start = startTime.AsTime()
+	}
+	if endTime != nil {
+		end = endTime.AsTime()
+	}
+	if !start.IsZero() && !end.IsZero() && !start.Before(end) {
+		return start, end, status.Error(codes.InvalidArgument, "start_time must be before end_time")
+	}
+	return start, end, nil
+}
+
+func snapshotSummary(board *storage.Leaderboard) *choicesv1.LeaderboardSnapshot {
+	return &choicesv1.LeaderboardSnapshot{
+		Id:        board.ID,
+		CreatedAt: timestamppb.New(board.CreatedAt),
+		Models:    uint32(len(board.Entries)),
+	}
+}
+
+// ranks returns the 1-based rank of every model by Elo score, the order used
+// by the web leaderboard.
+func ranks(board *storage.Leaderboard) map[string]uint32 {
+	entries := append([]storage.LeaderboardEntry(nil), board.Entries...)
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].EloScore > entries[j].EloScore
+	})
+	ranks := make(map[string]uint32, len(entries))
+	for i, entry := range entries {
+		ranks[entry.Model] = uint32(i + 1)
+	}
+	return ranks
+}
+
+func (s *Server) getLeaderboardSnapshot(ctx context.Context, id string) (*storage.Leaderboard, error) {
+	if id == "" {
+		return nil, status.Error(codes.InvalidArgument, "Snapshot ID is required")
+	}
+	board, err := s.storage.GetLeaderboard(ctx, id)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "Leaderboard snapshot not found: %s", id)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get leaderboard snapshot: %v", err)
+	}
+	return board, nil
+}
+
+func (s *Server) ListLeaderboardSnapshots(
+	ctx context.Context,
+	req *choicesv1.ListLeaderboardSnapshotsRequest,
+) (*choicesv1.ListLeaderboardSnapshotsResponse, error) {
+	start, end, err := timeRange(req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	var after *storage.LeaderboardCursor
+	if req.PageToken != "" {
+		after, err = decodeSnapshotsPageToken(req.PageToken)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid page token: %v", err)
+		}
+	}
+	pageSize := int(req.PageSize)
+	if pageSize == 0 {
+		pageSize = defaultSnapshotsPageSize
+	}
+	pageSize = min(pageSize, maxSnapshotsPageSize)
+
+	// Fetch one extra snapshot to know whether there is a next page.
+	boards, err := s.storage.ListLeaderboards(ctx, storage.ListLeaderboardsOptions{
+		Start: start,
+		End:   end,
+		After: after,
+		Limit: pageSize + 1,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to list leaderboard snapshots: %v", err)
+	}
+
+	resp := &choicesv1.ListLeaderboardSnapshotsResponse{}
+	if len(boards) > pageSize {
+		boards = boards[:pageSize]
+		resp.NextPageToken = encodeSnapshotsPageToken(&boards[len(boards)-1])
+	}
+	for i := range boards {
+		resp.Snapshots = append(resp.Snapshots, snapshotSummary(&boards[i]))
+	}
+	return resp, nil
+}
+
+func (s *Server) GetLeaderboardSnapshot(
+	ctx context.Context,
+	req *choicesv1.GetLeaderboardSnapshotRequest,
+) (*choicesv1.GetLeaderboardResponse, error) {
+	board, err := s.getLeaderboardSnapshot(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return leaderboardResponse(board), nil
+}
+
+func (s *Server) DiffLeaderboardSnapshots(
+	ctx context.Context,
+	req *choicesv1.DiffLeaderboardSnapshotsRequest,
+) (*choicesv1.DiffLeaderboardSnapshotsResponse, error) {
+	base, err := s.getLeaderboardSnapshot(ctx, req.BaseId)
+	if err != nil {
+		return nil, err
+	}
+	target, err := s.getLeaderboardSnapshot(ctx, req.TargetId)
+	if err != nil {
+		return nil, err
+	}
+
+	baseRanks, targetRanks := ranks(base), ranks(target)
+	baseEntries := make(map[string]storage.LeaderboardEntry, len(base.Entries))
+	for _, entry := range base.Entries {
+		baseEntries[entry.Model] = entry
+	}
+
+	entries := make([]*choicesv1.LeaderboardDiffEntry, 0, len(target.Entries))
+	for _, targetEntry := range target.Entries {
+		diff := &choicesv1.LeaderboardDiffEntry{
+			Model:      targetEntry.Model,
+			TargetRank: targetRanks[targetEntry.Model],
+		}
+		baseEntry, ok := baseEntries[targetEntry.Model]
+		if ok {
+			diff.BaseRank = baseRanks[targetEntry.Model]
+			diff.RankChange = int32(diff.BaseRank) - int32(diff.TargetRank)
+			diff.EloScoreDelta = targetEntry.EloScore - baseEntry.EloScore
+			diff.NewmanScoreDelta = targetEntry.NewmanScore - baseEntry.NewmanScore
+			diff.VotesDelta = targetEntry.Votes - baseEntry.Votes
+			delete(baseEntries, targetEntry.Model)
+		} else {
+			diff.Added = true
+			diff.EloScoreDelta = targetEntry.EloScore
+			diff.NewmanScoreDelta = targetEntry.NewmanScore
+			diff.VotesDelta = targetEntry.Votes
+		}
+		entries = append(entries, diff)
+	}
+	for model, baseEntry := range baseEntries {
+		entries = append(entries, &choicesv1.LeaderboardDiffEntry{
+			Model:            model,
+			BaseRank:         baseRanks[model],
+			EloScoreDelta:    -baseEntry.EloScore,
+			NewmanScoreDelta: -baseEntry.NewmanScore,
+			VotesDelta:       -baseEntry.Votes,
+			Removed:          true,
+		})
+	}
+	// Ranked models first by their new rank, then removed ones by their old.
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].Removed != entries[j].Removed {
+			return !entries[i].Removed
+		}
+		if entries[i].Removed {
+			return entries[i].BaseRank < entries[j].BaseRank
+		}
+		return entries[i].TargetRank < entries[j].TargetRank
+	})
+
+	return &choicesv1.DiffLeaderboardSnapshotsResponse{
+		Base:    snapshotSummary(base),
+		Target:  snapshotSummary(target),
+		Entries: entries,
+	}, nil
+}
+
+func (s *Server) GetModelHistory(
+	ctx context.Context,
+	req *choicesv1.GetModelHistoryRequest,
+) (*choicesv1.GetModelHistoryResponse, error) {
+	if req.Model == "" {
+		return nil, status.Error(codes.InvalidArgument, "Model is required")
+	}
+	start, end, err := timeRange(req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	boards, err := s.storage.ListLeaderboards(ctx, storage.ListLeaderboardsOptions{
+		Start: start,
+		End:   end,
+		Limit: maxModelHistorySnapshots,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to list leaderboard snapshots: %v", err)
+	}
+
+	points := make([]*choicesv1.ModelHistoryPoint, 0, len(boards))
+	// Snapshots are listed newest first.
+	for i := len(boards) - 1; i >= 0; i-- {
+		board := &boards[i]
+		for _, entry := range board.Entries {
+			if entry.Model != req.Model {
+				continue
+			}
+			points = append(points, &choicesv1.ModelHistoryPoint{
+				SnapshotId: board.ID,
+				CreatedAt:  timestamppb.New(board.CreatedAt),
+				Rank:       ranks(board)[entry.Model],
+				Entry:      leaderboardEntry(entry),
+			})
+			break
+		}
+	}
+	if len(points) == 0 {
+		return nil, status.Errorf(codes.NotFound, "Model not found in any snapshot: %s", req.Model)
+	}
+
+	return &choicesv1.GetModelHistoryResponse{
+		Points: points,
+	}, nil
+}
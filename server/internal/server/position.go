@@ -0,0 +1,70 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	choicesv1 "github.com/SaveTheRbtz/humor/gen/go/proto"
+	"github.com/SaveTheRbtz/humor/server/internal/leaderboard"
+	"github.com/SaveTheRbtz/humor/server/internal/ratings"
+)
+
+type positionFilter struct {
+	leaderboard.Filter
+	randomizedOnly bool
+}
+
+// getPositionBias builds the position bias report from the votes that pass
+// the filter. Results are cached per filter for the cache TTL, and the votes
+// are shared with the other filtered RPCs.
+func (s *Server) getPositionBias(ctx context.Context, filter positionFilter) (*leaderboard.PositionReport, error) {
+	return s.positionCache.get(ctx, filter, func(ctx context.Context) (*leaderboard.PositionReport, error) {
+		v, err := s.listVotes(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return leaderboard.PositionBias(v.choices, v.jokes, filter.Filter, filter.randomizedOnly, ratings.DefaultSolverOptions())
+	})
+}
+
+func (s *Server) GetPositionBias(
+	ctx context.Context,
+	req *choicesv1.GetPositionBiasRequest,
+) (*choicesv1.GetPositionBiasResponse, error) {
+	filter, err := s.newFilter(ctx, req.ThemeSet, req.Policy, req.StartTime, req.EndTime, req.ExcludeKnown)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := s.getPositionBias(ctx, positionFilter{filter, req.RandomizedOnly})
+	if errors.Is(err, leaderboard.ErrNoRatedChoices) {
+		return nil, status.Errorf(codes.NotFound, "No votes match the filter: %v", err)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get position bias: %v", err)
+	}
+
+	resp := &choicesv1.GetPositionBiasResponse{
+		Left:          headToHeadRecord(report.Record),
+		LeftAdvantage: report.Advantage,
+		Entries:       make([]*choicesv1.PositionBiasEntry, 0, len(report.Entries)),
+	}
+	if n := report.Wins + report.Losses + report.Ties; n > 0 {
+		p := report.WinRate()
+		resp.LeftWinRateStderr = math.Sqrt(p * (1 - p) / float64(n))
+	}
+	for _, entry := range report.Entries {
+		resp.Entries = append(resp.Entries, &choicesv1.PositionBiasEntry{
+			Model:          entry.Model,
+			Left:           headToHeadRecord(entry.Left),
+			Right:          headToHeadRecord(entry.Right),
+			Score:          entry.Score,
+			CorrectedScore: entry.CorrectedScore,
+		})
+	}
+	return resp, nil
+}
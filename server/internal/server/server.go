@@ -2,278 +2,264 @@ package server
 
 import (
 	"context"
+	"encoding/base64"
+	"errors"
 	"fmt"
+	insecureRand "math/rand/v2"
+	"strconv"
 	"strings"
+	"sync/atomic"
 
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
-	"cloud.google.com/go/firestore"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"time"
 
-	insecureRandExp "golang.org/x/exp/rand"
-
 	choicesv1 "github.com/SaveTheRbtz/humor/gen/go/proto"
-
-	"google.golang.org/api/iterator"
+	"github.com/SaveTheRbtz/humor/server/internal/choicetoken"
+	"github.com/SaveTheRbtz/humor/server/internal/leaderboard"
+	"github.com/SaveTheRbtz/humor/server/internal/metrics"
+	"github.com/SaveTheRbtz/humor/server/internal/sampler"
+	"github.com/SaveTheRbtz/humor/server/internal/storage"
 
 	"github.com/google/uuid"
 
-	"gonum.org/v1/gonum/stat/sampleuv"
-
 	// embed
 	_ "embed"
 )
 
-// TODO(rbtz): automate rank generation and open-source policy geneeration.
+// TODO(rbtz): open-source policy geneeration.
 
+// topJokesData is served by GetTopJokes until the leaderboard job stores a
+// top jokes snapshot.
+//
 //go:embed "top-jokes.txt"
 var topJokesData string
 
-type leaderboardEntry struct {
-	Model         string  `firestore:"model"`
-	Votes         int64   `firestore:"votes"`
-	VotesGood     int64   `firestore:"votes_good"`
-	VotesBad      int64   `firestore:"votes_bad"`
-	NewmanScore   float64 `firestore:"newman_score"`
-	NewmanCiLower float64 `firestore:"newman_ci_lower"`
-	NewmanCiUpper float64 `firestore:"newman_ci_upper"`
-	EloScore      float64 `firestore:"elo_score"`
-	EloCiLower    float64 `firestore:"elo_ci_lower"`
-	EloCiUpper    float64 `firestore:"elo_ci_upper"`
-}
+var tracer = otel.Tracer("github.com/SaveTheRbtz/humor/server/internal/server")
 
-type themesCache struct {
-	topics    []string
+type topJokesCache struct {
+	topJokes  *storage.TopJokes
 	timestamp time.Time
 }
 
-type Theme struct {
-	Text   string  `firestore:"text"`
-	Random float64 `firestore:"random"`
-	Active bool    `firestore:"active"`
-}
-
-type Joke struct {
-	Theme   string  `firestore:"theme"`
-	ThemeID string  `firestore:"theme_id"`
-	Text    string  `firestore:"text"`
-	Random  float64 `firestore:"random"`
-	Model   string  `firestore:"model"`
-	Policy  string  `firestore:"policy"`
-	Active  bool    `firestore:"active"`
-}
-
-type Choice struct {
-	ThemeID     string            `firestore:"theme_id"`
-	SessionID   string            `firestore:"session_id"`
-	LeftJokeID  string            `firestore:"left_joke_id"`
-	RightJokeID string            `firestore:"right_joke_id"`
-	Winner      *choicesv1.Winner `firestore:"winner,omitempty"`
-	Known       *choicesv1.Winner `firestore:"known,omitempty"`
-	CreatedAt   time.Time         `firestore:"created_at"`
-	RatedAt     *time.Time        `firestore:"rated_at,omitempty"`
-}
-
-var modelWeights struct {
-	ModelWeights []float64 `firestore:"model_weights"`
-	Shape        []int     `firestore:"shape"`
-	Models       []string  `firestore:"models"`
-	CreatedAt    time.Time `firestore:"created_at"`
-}
-
 type Server struct {
 	choicesv1.UnimplementedArenaServer
 
-	logger          *zap.Logger
-	firestoreClient *firestore.Client
-	rand            *insecureRandExp.Rand
-	source          insecureRandExp.Source
-	themeGetter     *randomDocumentGetterImpl[Theme]
+	logger  *zap.Logger
+	storage storage.Storage
+	sampler sampler.Sampler
+	// tokens encrypts unrated choices into their IDs instead of storing them.
+	// Nil stores every choice served.
+	tokens *choicetoken.Sealer
+	// choiceExpiry is how long after it was served a choice can be rated;
+	// zero disables the check.
+	choiceExpiry time.Duration
+	// cacheTTL is how long computed leaderboards and top jokes are reused.
+	cacheTTL time.Duration
+
+	topJokesCache atomic.Pointer[topJokesCache]
+
+	votesCache       *filterCache[struct{}, *votes]
+	leaderboardCache *filterCache[leaderboard.Filter, *storage.Leaderboard]
+	headToHeadCache  *filterCache[leaderboard.Filter, []leaderboard.HeadToHead]
+	positionCache    *filterCache[positionFilter, *leaderboard.PositionReport]
+}
 
-	modelWeightsCache          [][]float64
-	modelWeightsCacheTimestamp time.Time
+// Options configures a Server.
+type Options struct {
+	// ChoiceTokens encrypts unrated choices into their IDs instead of storing
+	// them. Nil stores every choice served.
+	ChoiceTokens *choicetoken.Sealer
+	// ChoiceExpiry is how long after it was served a choice can be rated;
+	// zero disables the check.
+	ChoiceExpiry time.Duration
+	// CacheTTL is how long computed leaderboards and top jokes are reused.
+	CacheTTL time.Duration
 }
 
 func NewServer(
-	firestoreClient *firestore.Client,
+	store storage.Storage,
+	pairSampler sampler.Sampler,
 	logger *zap.Logger,
+	opts Options,
 ) (*Server, error) {
-	randomThemeGetter, err := NewRandomDocumentGetter[Theme](
-		firestoreClient,
-		firestoreClient.Collection("themes").Query,
-		time.Minute,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create random theme getter: %w", err)
-	}
-	source := insecureRandExp.NewSource(uint64(time.Now().UnixNano()))
-
 	return &Server{
-		logger:          logger,
-		firestoreClient: firestoreClient,
-		themeGetter:     randomThemeGetter,
-		rand:            insecureRandExp.New(source),
-		source:          source,
+		logger:       logger,
+		storage:      store,
+		sampler:      pairSampler,
+		tokens:       opts.ChoiceTokens,
+		choiceExpiry: opts.ChoiceExpiry,
+		cacheTTL:     opts.CacheTTL,
+
+		votesCache: newFilterCache[struct{}, *votes]("votes", opts.CacheTTL, 1),
+		leaderboardCache: newFilterCache[leaderboard.Filter, *storage.Leaderboard](
+			"leaderboard", opts.CacheTTL, maxFilterCacheEntries),
+		headToHeadCache: newFilterCache[leaderboard.Filter, []leaderboard.HeadToHead](
+			"head_to_head", opts.CacheTTL, maxFilterCacheEntries),
+		positionCache: newFilterCache[positionFilter, *leaderboard.PositionReport](
+			"position_bias", opts.CacheTTL, maxFilterCacheEntries),
 	}, nil
 }
 
-func (s *Server) getAllModelWeights(ctx context.Context) ([][]float64, error) {
-	if s.modelWeightsCache != nil && time.Since(s.modelWeightsCacheTimestamp) < time.Minute {
-		return s.modelWeightsCache, nil
-	}
-
-	modelWeightsCollection := s.firestoreClient.Collection("model_weights")
-	query := modelWeightsCollection.OrderBy("created_at", firestore.Desc).Limit(1)
+// maxThemeAttempts is how many random themes GetChoices tries before giving
+// up on finding one with enough jokes in the requested theme set.
+const maxThemeAttempts = 5
 
-	docIter := query.Documents(ctx)
-	docSnap, err := docIter.Next()
+// getActiveJokes returns active jokes for a theme, optionally restricted to a
+// theme set.
+func (s *Server) getActiveJokes(ctx context.Context, theme *storage.Theme, themeSet string) ([]storage.Joke, error) {
+	jokes, err := s.storage.GetActiveJokesByTheme(ctx, theme.Text)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get model weights: %w", err)
+		return nil, status.Errorf(codes.Internal, "Failed to get jokes: %v", err)
 	}
-
-	if err := docSnap.DataTo(&modelWeights); err != nil {
-		return nil, fmt.Errorf("failed to parse model weights document: %w", err)
+	if themeSet == "" {
+		return jokes, nil
 	}
-
-	// reshape model weights
-	modelWeightsMatrix := make([][]float64, modelWeights.Shape[0])
-	for i := range modelWeightsMatrix {
-		modelWeightsMatrix[i] = modelWeights.ModelWeights[i*modelWeights.Shape[1] : (i+1)*modelWeights.Shape[1]]
+	filteredJokes := make([]storage.Joke, 0, len(jokes))
+	for _, joke := range jokes {
+		if joke.ThemeSet == themeSet {
+			filteredJokes = append(filteredJokes, joke)
+		}
 	}
-
-	s.modelWeightsCache = modelWeightsMatrix
-	s.modelWeightsCacheTimestamp = time.Now()
-
-	return modelWeightsMatrix, nil
+	return filteredJokes, nil
 }
 
-func (s *Server) getJokesForModel(ctx context.Context, model string, jokes []Joke, jokeDocs []*firestore.DocumentSnapshot) ([]Joke, []*firestore.DocumentSnapshot, error) {
-	modelWeightsMatrix, err := s.getAllModelWeights(ctx)
-	if err != nil {
-		return nil, nil, fmt.Errorf("failed to get all model weights: %w", err)
-	}
+// getThemeJokes resolves the theme requested by the user, or draws a random
+// one, and returns it together with at least two of its active jokes.
+func (s *Server) getThemeJokes(ctx context.Context, req *choicesv1.GetChoicesRequest) (*storage.Theme, []storage.Joke, error) {
+	ctx, span := tracer.Start(ctx, "getThemeJokes", trace.WithAttributes(
+		attribute.String("arena.sampler", s.sampler.Name()),
+		attribute.String("arena.theme_set", req.ThemeSet),
+	))
+	defer span.End()
+
+	if req.ThemeId != "" || req.Theme != "" {
+		lookup, getTheme := req.ThemeId, s.storage.GetTheme
+		if lookup == "" {
+			lookup, getTheme = req.Theme, s.storage.GetThemeByText
+		}
+		theme, err := getTheme(ctx, lookup)
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, nil, status.Errorf(codes.NotFound, "Theme not found: %s", lookup)
+		}
+		if err != nil {
+			return nil, nil, status.Errorf(codes.Internal, "Failed to get theme: %v", err)
+		}
+		if !theme.Active {
+			return nil, nil, status.Errorf(codes.FailedPrecondition, "Theme is not active: %s", theme.Text)
+		}
 
-	leftModelWeights := make([]float64, 0)
-	foundModelID := -1
-	for modedID, m := range modelWeights.Models {
-		if m == model {
-			leftModelWeights = modelWeightsMatrix[modedID]
-			foundModelID = modedID
-			break
+		jokes, err := s.getActiveJokes(ctx, theme, req.ThemeSet)
+		if err != nil {
+			return nil, nil, err
 		}
-	}
-	if foundModelID == -1 {
-		return nil, nil, fmt.Errorf("model not found in model weights: %s, %v", model, modelWeights.Models)
+		if len(jokes) < 2 {
+			return nil, nil, status.Errorf(codes.FailedPrecondition,
+				"Not enough active jokes: %d, theme: %s, theme set: %q", len(jokes), theme.Text, req.ThemeSet)
+		}
+		return theme, jokes, nil
 	}
 
-	rightModelIdx, ok := sampleuv.NewWeighted(leftModelWeights, s.source).Take()
-	if !ok {
-		return nil, nil, fmt.Errorf("failed to sample model: %w", err)
+	attempts := 1
+	if req.ThemeSet != "" {
+		attempts = maxThemeAttempts
 	}
-	rightModel := modelWeights.Models[rightModelIdx]
+	var theme *storage.Theme
+	var jokes []storage.Joke
+	for attempt := 0; attempt < attempts; attempt++ {
+		var err error
+		theme, err = s.sampler.SampleTheme(ctx)
+		if err != nil {
+			return nil, nil, status.Errorf(codes.Internal, "Failed to get random theme: %v", err)
+		}
 
-	var filteredJokes []Joke
-	var filteredJokeDocs []*firestore.DocumentSnapshot
-	for i, joke := range jokes {
-		if joke.Model != rightModel {
-			continue
+		jokes, err = s.getActiveJokes(ctx, theme, req.ThemeSet)
+		if err != nil {
+			return nil, nil, err
 		}
-		if !joke.Active {
-			continue
+		if len(jokes) >= 2 {
+			return theme, jokes, nil
 		}
-		filteredJokes = append(filteredJokes, joke)
-		filteredJokeDocs = append(filteredJokeDocs, jokeDocs[i])
 	}
-	if len(filteredJokes) < 1 {
-		return nil, nil, fmt.Errorf("no jokes found for model: %s", rightModel)
-	}
-
-	s.logger.Debug("Filtered jokes count", zap.Int("count", len(filteredJokes)))
-
-	return filteredJokes, filteredJokeDocs, nil
+	return nil, nil, status.Errorf(codes.NotFound,
+		"Not enough jokes found: %d, theme: %s, theme set: %q", len(jokes), theme.Text, req.ThemeSet)
 }
 
 func (s *Server) GetChoices(
 	ctx context.Context,
 	req *choicesv1.GetChoicesRequest,
 ) (*choicesv1.GetChoicesResponse, error) {
-	themes, themeDocs, err := s.themeGetter.GetRandomDocuments(ctx, 1)
+	theme, jokes, err := s.getThemeJokes(ctx, req)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Failed to get random theme: %v", err)
+		return nil, err
 	}
-	theme, themeDoc := themes[0], themeDocs[0]
-	s.logger.Debug("GetChoices", zap.String("theme_id", themeDoc.Ref.ID), zap.String("theme_text", theme.Text))
-
-	// Get all jokes for a theme
-	allJokeDocs, err := s.firestoreClient.Collection("jokes").Query.Where("theme", "==", theme.Text).Documents(ctx).GetAll()
+	s.logger.Debug("GetChoices", zap.String("theme_id", theme.ID), zap.String("theme_text", theme.Text))
+
+	sampleCtx, span := tracer.Start(ctx, "SamplePair", trace.WithAttributes(
+		attribute.String("arena.sampler", s.sampler.Name()),
+		attribute.String("arena.theme_id", theme.ID),
+		attribute.Int("arena.jokes", len(jokes)),
+	))
+	leftJoke, rightJoke, err := s.sampler.SamplePair(sampleCtx, jokes)
+	span.End()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get jokes: %w", err)
-	}
-
-	// Collect all jokes
-	jokes := make([]Joke, 0)
-	jokeDocs := make([]*firestore.DocumentSnapshot, 0)
-	for _, doc := range allJokeDocs {
-		if active_raw, ok := doc.Data()["active"]; ok {
-			if active := active_raw.(bool); active {
-				var joke Joke
-				if err := doc.DataTo(&joke); err != nil {
-					return nil, fmt.Errorf("failed to parse joke document: %w", err)
-				}
-				jokes = append(jokes, joke)
-				jokeDocs = append(jokeDocs, doc)
-			}
-		}
+		return nil, status.Errorf(codes.Internal, "Failed to sample jokes: %v", err)
 	}
-	if len(jokes) < 2 {
-		return nil, status.Errorf(codes.NotFound, "Not enough jokes found: %d, theme: %s", len(jokes), theme.Text)
+	// Samplers may treat the two jokes differently, so sides are assigned
+	// independently to keep models uncorrelated with the screen side.
+	swapped := insecureRand.IntN(2) == 1
+	if swapped {
+		leftJoke, rightJoke = rightJoke, leftJoke
 	}
-	leftJokeID := s.rand.Int63n(int64(len(jokes)))
-	leftJokeDoc := jokeDocs[leftJokeID]
-	leftJoke := jokes[leftJokeID]
-
-	filteredJokes, filteredJokeDocs, err := s.getJokesForModel(ctx, leftJoke.Model, jokes, jokeDocs)
-	if err != nil {
-		filteredJokes = jokes
-		filteredJokeDocs = jokeDocs
-		s.logger.Warn("GetChoices failed to get jokes for model", zap.String("model", leftJoke.Model), zap.String("theme", theme.Text), zap.Error(err))
-	}
-
-	rightJokeID := s.rand.Int63n(int64(len(filteredJokes)))
-	rightJokeDoc := filteredJokeDocs[rightJokeID]
-	rightJoke := filteredJokes[rightJokeID]
 
 	s.logger.Debug("GetChoices",
-		zap.String("left_joke_id", leftJokeDoc.Ref.ID),
+		zap.String("left_joke_id", leftJoke.ID),
 		zap.String("left_joke_text", leftJoke.Text),
-		zap.String("right_joke_id", rightJokeDoc.Ref.ID),
+		zap.String("right_joke_id", rightJoke.ID),
 		zap.String("right_joke_text", rightJoke.Text),
 	)
 
 	id := uuid.New().String()
 	noWinner := choicesv1.Winner_UNSPECIFIED
-	choice := Choice{
+	choice := storage.Choice{
 		SessionID: req.SessionId,
 
-		ThemeID:     themeDoc.Ref.ID,
-		LeftJokeID:  leftJokeDoc.Ref.ID,
-		RightJokeID: rightJokeDoc.Ref.ID,
+		ThemeID:     theme.ID,
+		LeftJokeID:  leftJoke.ID,
+		RightJokeID: rightJoke.ID,
 		CreatedAt:   time.Now(),
+		Sampler:     s.sampler.Name(),
+		Swapped:     &swapped,
+		Theme:       theme.Text,
+		LeftJoke:    storage.NewChoiceJoke(&leftJoke),
+		RightJoke:   storage.NewChoiceJoke(&rightJoke),
 
 		Winner: &noWinner,
 	}
 
-	_, err = s.firestoreClient.Collection("choices").Doc(id).Set(ctx, choice)
-	if err != nil {
+	if s.tokens != nil {
+		// The choice is stored by RateChoices once it is rated.
+		id, err = s.tokens.Seal(id, choice)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Failed to seal choice: %v", err)
+		}
+	} else if err := s.storage.SaveChoice(ctx, id, choice); err != nil {
 		return nil, status.Errorf(codes.Internal, "Failed to save choice: %v", err)
 	}
 
+	metrics.PairsServed.WithLabelValues(choice.Sampler).Inc()
+
 	return &choicesv1.GetChoicesResponse{
 		Id:        id,
 		Theme:     theme.Text,
+		ThemeId:   theme.ID,
 		LeftJoke:  leftJoke.Text,
 		RightJoke: rightJoke.Text,
 	}, nil
@@ -286,82 +272,344 @@ func (s *Server) RateChoices(ctx context.Context, req *choicesv1.RateChoicesRequ
 	if req.Winner == choicesv1.Winner_UNSPECIFIED {
 		return nil, status.Error(codes.InvalidArgument, "Winner is required")
 	}
+	if !storage.IsRated(&req.Winner) {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid winner: %d", req.Winner)
+	}
 	if req.Known == choicesv1.Winner_UNSPECIFIED {
 		return nil, status.Error(codes.InvalidArgument, "Known is required")
 	}
+	if !storage.IsRated(&req.Known) {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid known: %d", req.Known)
+	}
+
+	id := req.Id
+	var unrated *storage.Choice
+	if choicetoken.IsToken(id) {
+		if s.tokens == nil {
+			return nil, status.Error(codes.InvalidArgument, "Choice tokens are not enabled")
+		}
+		var err error
+		unrated, err = s.tokens.Open(id)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "Invalid choice token")
+		}
+		// Choices served as tokens are only stored once rated.
+		id = unrated.ID
+	}
+
+	vote := storage.Vote{
+		SessionID: req.SessionId,
+		Winner:    req.Winner,
+		Known:     req.Known,
+		RatedAt:   time.Now(),
+		Expiry:    s.choiceExpiry,
+	}
+	choice, err := s.storage.RateChoice(ctx, id, vote, unrated)
+	switch {
+	case errors.Is(err, storage.ErrNotFound):
+		return nil, status.Errorf(codes.NotFound, "Choice not found: %s", id)
+	case errors.Is(err, storage.ErrSessionMismatch):
+		return nil, status.Errorf(codes.PermissionDenied, "Choice was served to another session: %s", id)
+	case errors.Is(err, storage.ErrAlreadyRated):
+		return nil, status.Errorf(codes.AlreadyExists, "Choice is already rated: %s", id)
+	case errors.Is(err, storage.ErrExpired):
+		return nil, status.Errorf(codes.FailedPrecondition, "Choice has expired: %s", id)
+	case err != nil:
+		return nil, status.Errorf(codes.Internal, "Failed to update choice: %v", err)
+	}
+
+	// An identical re-submission keeps the original rating time.
+	counted := choice.RatedAt != nil && choice.RatedAt.Equal(vote.RatedAt)
+	if counted {
+		metrics.Votes.WithLabelValues(req.Winner.String()).Inc()
+	}
+
+	// The vote is already stored, so failing to reveal the jokes should not
+	// fail the request.
+	leftJoke, rightJoke, err := s.choiceJokes(ctx, choice)
+	if err != nil {
+		s.logger.Warn("RateChoices failed to reveal jokes", zap.String("id", id), zap.Error(err))
+		return &choicesv1.RateChoicesResponse{}, nil
+	}
+	if counted {
+		metrics.ModelVotes.WithLabelValues(leftJoke.Model).Inc()
+		metrics.ModelVotes.WithLabelValues(rightJoke.Model).Inc()
+	}
+	return &choicesv1.RateChoicesResponse{
+		LeftJoke:  jokeInfo(leftJoke),
+		RightJoke: jokeInfo(rightJoke),
+	}, nil
+}
+
+// jokeInfo reveals the generation parameters of a joke. The model is named
+// as on the leaderboard.
+func jokeInfo(joke *storage.Joke) *choicesv1.JokeInfo {
+	return &choicesv1.JokeInfo{
+		Model:    joke.Model,
+		Policy:   joke.Policy,
+		ThemeSet: joke.ThemeSet,
+	}
+}
+
+// choiceJokes returns both jokes of a choice with their generation
+// parameters. They are taken from the snapshots stored with the choice, and
+// only choices served before snapshots existed read the jokes from storage.
+func (s *Server) choiceJokes(ctx context.Context, choice *storage.Choice) (left, right *storage.Joke, err error) {
+	left, right, _ = choice.Jokes(nil)
+	if left == nil {
+		if left, err = s.storage.GetJoke(ctx, choice.LeftJokeID); err != nil {
+			return nil, nil, fmt.Errorf("failed to get left joke: %w", err)
+		}
+	}
+	if right == nil {
+		if right, err = s.storage.GetJoke(ctx, choice.RightJokeID); err != nil {
+			return nil, nil, fmt.Errorf("failed to get right joke: %w", err)
+		}
+	}
+	return left, right, nil
+}
+
+// votes holds all rated choices together with the jokes they refer to, and
+// the theme sets and policies that filters may select.
+type votes struct {
+	choices   []storage.Choice
+	jokes     []storage.Joke
+	themeSets map[string]bool
+	policies  map[string]bool
+}
+
+// listVotes returns all rated choices together with the jokes they refer to.
+// Every filtered RPC computes from the same scan, which is reused for the
+// cache TTL.
+func (s *Server) listVotes(ctx context.Context) (*votes, error) {
+	return s.votesCache.get(ctx, struct{}{}, func(ctx context.Context) (*votes, error) {
+		choices, err := s.storage.ListRatedChoices(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get choices: %w", err)
+		}
+		jokes, err := s.storage.ListActiveJokes(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get jokes: %w", err)
+		}
+
+		v := &votes{
+			choices:   choices,
+			jokes:     jokes,
+			themeSets: make(map[string]bool),
+			policies:  make(map[string]bool),
+		}
+		for _, joke := range jokes {
+			v.themeSets[joke.ThemeSet] = true
+			v.policies[joke.Policy] = true
+		}
+		// Votes on deactivated jokes are only known from the snapshots.
+		for _, choice := range choices {
+			for _, joke := range []*storage.ChoiceJoke{choice.LeftJoke, choice.RightJoke} {
+				if joke != nil {
+					v.themeSets[joke.ThemeSet] = true
+					v.policies[joke.Policy] = true
+				}
+			}
+		}
+		return v, nil
+	})
+}
 
-	_, err := s.firestoreClient.Collection("choices").Doc(req.Id).Update(ctx, []firestore.Update{
-		{
-			Path:  "winner",
-			Value: req.Winner.Number(),
-		},
-		{
-			Path:  "known",
-			Value: req.Known.Number(),
-		},
-		{
-			Path:  "rated_at",
-			Value: time.Now(),
-		},
+// filteredBootstrapSamples is the number of bootstrap samples of leaderboards
+// computed on request. They are fewer than for the stored leaderboard to
+// bound the cost of a cache miss.
+const filteredBootstrapSamples = 200
+
+// getFilteredLeaderboard computes a leaderboard from the votes that pass the
+// filter. Results are cached per filter for the cache TTL.
+func (s *Server) getFilteredLeaderboard(ctx context.Context, filter leaderboard.Filter) (*storage.Leaderboard, error) {
+	return s.leaderboardCache.get(ctx, filter, func(ctx context.Context) (*storage.Leaderboard, error) {
+		v, err := s.listVotes(ctx)
+		if err != nil {
+			return nil, err
+		}
+		opts := leaderboard.DefaultOptions()
+		opts.Bootstrap.Samples = filteredBootstrapSamples
+		result, err := leaderboard.Compute(s.logger, v.choices, v.jokes, filter, opts)
+		if err != nil {
+			return nil, err
+		}
+		result.Leaderboard.CreatedAt = time.Now()
+		return &result.Leaderboard, nil
 	})
+}
+
+// newFilter validates the vote filter shared by the leaderboard RPCs. The
+// time range is widened to whole UTC days so that nearby ranges share cache
+// entries, and theme sets and policies must appear in the votes.
+func (s *Server) newFilter(
+	ctx context.Context,
+	themeSet, policy string,
+	startTime, endTime *timestamppb.Timestamp,
+	excludeKnown bool,
+) (leaderboard.Filter, error) {
+	start, end, err := timeRange(startTime, endTime)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Failed to update choice: %v", err)
+		return leaderboard.Filter{}, err
 	}
+	if themeSet != "" || policy != "" {
+		v, err := s.listVotes(ctx)
+		if err != nil {
+			return leaderboard.Filter{}, status.Errorf(codes.Internal, "Failed to get votes: %v", err)
+		}
+		if themeSet != "" && !v.themeSets[themeSet] {
+			return leaderboard.Filter{}, status.Errorf(codes.InvalidArgument, "Unknown theme set: %q", themeSet)
+		}
+		if policy != "" && !v.policies[policy] {
+			return leaderboard.Filter{}, status.Errorf(codes.InvalidArgument, "Unknown policy: %q", policy)
+		}
+	}
+	return leaderboard.Filter{
+		ThemeSet:     themeSet,
+		Policy:       policy,
+		Start:        floorDay(start),
+		End:          ceilDay(end),
+		ExcludeKnown: excludeKnown,
+	}, nil
+}
 
-	return &choicesv1.RateChoicesResponse{}, nil
+// floorDay returns the start of the UTC day of t; the zero time is kept.
+func floorDay(t time.Time) time.Time {
+	if t.IsZero() {
+		return t
+	}
+	return t.UTC().Truncate(24 * time.Hour)
+}
+
+// ceilDay returns the start of the UTC day after t unless t already starts a
+// day; the zero time is kept.
+func ceilDay(t time.Time) time.Time {
+	day := floorDay(t)
+	if day.Equal(t) {
+		return day
+	}
+	return day.Add(24 * time.Hour)
 }
 
 func (s *Server) GetLeaderboard(
 	ctx context.Context,
 	req *choicesv1.GetLeaderboardRequest,
 ) (*choicesv1.GetLeaderboardResponse, error) {
-	leaderboardCollection := s.firestoreClient.Collection("leaderboard")
-	query := leaderboardCollection.OrderBy("created_at", firestore.Desc).Limit(1)
-	docIter := query.Documents(ctx)
-	docSnap, err := docIter.Next()
-	if err == iterator.Done {
-		return nil, status.Error(codes.NotFound, "No leaderboard found")
+	filter, err := s.newFilter(ctx, req.ThemeSet, req.Policy, req.StartTime, req.EndTime, req.ExcludeKnown)
+	if err != nil {
+		return nil, err
+	}
+
+	var board *storage.Leaderboard
+	if filter.IsZero() {
+		board, err = s.storage.GetLatestLeaderboard(ctx)
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "No leaderboard found")
+		}
+	} else {
+		board, err = s.getFilteredLeaderboard(ctx, filter)
+		if errors.Is(err, leaderboard.ErrNoRatedChoices) {
+			return nil, status.Errorf(codes.NotFound, "No votes match the filter: %v", err)
+		}
 	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to get leaderboard: %w", err)
 	}
 
-	var leaderboardDoc struct {
-		Leaderboard []leaderboardEntry `firestore:"leaderboard"`
-		CreatedAt   time.Time          `firestore:"created_at"`
+	return leaderboardResponse(board), nil
+}
+
+func leaderboardEntry(entryData storage.LeaderboardEntry) *choicesv1.LeaderboardEntry {
+	return &choicesv1.LeaderboardEntry{
+		Model:         entryData.Model,
+		Votes:         uint64(entryData.Votes),
+		VotesGood:     uint64(entryData.VotesGood),
+		VotesBad:      uint64(entryData.VotesBad),
+		NewmanScore:   entryData.NewmanScore,
+		NewmanCILower: entryData.NewmanCiLower,
+		NewmanCIUpper: entryData.NewmanCiUpper,
+		EloScore:      entryData.EloScore,
+		EloCILower:    entryData.EloCiLower,
+		EloCIUpper:    entryData.EloCiUpper,
 	}
-	if err := docSnap.DataTo(&leaderboardDoc); err != nil {
-		return nil, fmt.Errorf("failed to parse leaderboard document: %w", err)
+}
+
+// leaderboardResponse maps a stored leaderboard to choicesv1.GetLeaderboardResponse.
+func leaderboardResponse(board *storage.Leaderboard) *choicesv1.GetLeaderboardResponse {
+	entries := make([]*choicesv1.LeaderboardEntry, 0, len(board.Entries))
+	for _, entryData := range board.Entries {
+		entries = append(entries, leaderboardEntry(entryData))
 	}
 
-	// Map the entries to choicesv1.LeaderboardEntry
-	entries := make([]*choicesv1.LeaderboardEntry, 0, len(leaderboardDoc.Leaderboard))
-	for _, entryData := range leaderboardDoc.Leaderboard {
-		entry := &choicesv1.LeaderboardEntry{
-			Model:         entryData.Model,
-			Votes:         uint64(entryData.Votes),
-			VotesGood:     uint64(entryData.VotesGood),
-			VotesBad:      uint64(entryData.VotesBad),
-			NewmanScore:   entryData.NewmanScore,
-			NewmanCILower: entryData.NewmanCiLower,
-			NewmanCIUpper: entryData.NewmanCiUpper,
-			EloScore:      entryData.EloScore,
-			EloCILower:    entryData.EloCiLower,
-			EloCIUpper:    entryData.EloCiUpper,
-		}
+	return &choicesv1.GetLeaderboardResponse{
+		Entries:   entries,
+		Id:        board.ID,
+		CreatedAt: timestamppb.New(board.CreatedAt),
+	}
+}
+
+const (
+	// defaultTopJokesPageSize is how many top jokes are served by default,
+	// as promised by the README.
+	defaultTopJokesPageSize = 50
+	maxTopJokesPageSize     = 1000
+)
 
-		entries = append(entries, entry)
+// getTopJokes returns the latest top jokes snapshot, cached for a minute.
+func (s *Server) getTopJokes(ctx context.Context) (*storage.TopJokes, error) {
+	cached := s.topJokesCache.Load()
+	hit := cached != nil && time.Since(cached.timestamp) < s.cacheTTL
+	metrics.CacheLookup("top_jokes", hit)
+	if hit {
+		return cached.topJokes, nil
 	}
 
-	return &choicesv1.GetLeaderboardResponse{
-		Entries: entries,
-	}, nil
+	topJokes, err := s.storage.GetLatestTopJokes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get top jokes: %w", err)
+	}
+	s.topJokesCache.Store(&topJokesCache{
+		topJokes:  topJokes,
+		timestamp: time.Now(),
+	})
+	return topJokes, nil
 }
 
-func (s *Server) GetTopJokes(
-	ctx context.Context,
-	req *choicesv1.GetTopJokesRequest,
-) (*choicesv1.GetTopJokesResponse, error) {
+// getTopJokesByID returns the top jokes snapshot a page token refers to.
+func (s *Server) getTopJokesByID(ctx context.Context, id string) (*storage.TopJokes, error) {
+	if cached := s.topJokesCache.Load(); cached != nil && cached.topJokes.ID == id {
+		return cached.topJokes, nil
+	}
+	return s.storage.GetTopJokes(ctx, id)
+}
+
+// encodeTopJokesPageToken returns an opaque token that continues listing the
+// snapshot from the entry at position.
+func encodeTopJokesPageToken(id string, position int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%s/%d", id, position)))
+}
+
+func decodeTopJokesPageToken(token string) (string, int, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return "", 0, err
+	}
+	i := strings.LastIndexByte(string(raw), '/')
+	if i < 0 {
+		return "", 0, errors.New("missing position")
+	}
+	position, err := strconv.Atoi(string(raw[i+1:]))
+	if err != nil {
+		return "", 0, err
+	}
+	if position < 0 {
+		return "", 0, fmt.Errorf("negative position: %d", position)
+	}
+	return string(raw[:i]), position, nil
+}
+
+// fallbackTopJokes returns the curated list embedded in the binary.
+func fallbackTopJokes() []*choicesv1.TopJokesEntry {
 	entries := make([]*choicesv1.TopJokesEntry, 0, 10)
 	// read lines from topJokesData
 	for i, line := range strings.Split(topJokesData, "\n") {
@@ -373,12 +621,94 @@ func (s *Server) GetTopJokes(
 			Text: line,
 		})
 	}
+	return entries
+}
 
-	return &choicesv1.GetTopJokesResponse{
-		Entries: entries,
-	}, nil
+func matchTopJoke(req *choicesv1.GetTopJokesRequest, entry *storage.TopJoke) bool {
+	if req.Model != "" && entry.Model != req.Model {
+		return false
+	}
+	if req.ThemeId != "" {
+		return entry.ThemeID == req.ThemeId
+	}
+	return req.Theme == "" || entry.Theme == req.Theme
+}
+
+func (s *Server) GetTopJokes(
+	ctx context.Context,
+	req *choicesv1.GetTopJokesRequest,
+) (*choicesv1.GetTopJokesResponse, error) {
+	pageSize := int(req.PageSize)
+	if pageSize == 0 {
+		pageSize = defaultTopJokesPageSize
+	}
+	pageSize = min(pageSize, maxTopJokesPageSize)
+
+	var topJokes *storage.TopJokes
+	position := 0
+	if req.PageToken != "" {
+		id, tokenPosition, err := decodeTopJokesPageToken(req.PageToken)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid page token: %v", err)
+		}
+		topJokes, err = s.getTopJokesByID(ctx, id)
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid page token: unknown snapshot: %s", id)
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Failed to get top jokes: %v", err)
+		}
+		position = tokenPosition
+	} else {
+		var err error
+		topJokes, err = s.getTopJokes(ctx)
+		if err == nil && len(topJokes.Entries) == 0 {
+			err = fmt.Errorf("top jokes snapshot is empty: %s", topJokes.ID)
+		}
+		if err != nil {
+			if !errors.Is(err, storage.ErrNotFound) {
+				s.logger.Warn("GetTopJokes falling back to the embedded list", zap.Error(err))
+			}
+			// The embedded list has no metadata to filter on.
+			if req.Model != "" || req.ThemeId != "" || req.Theme != "" {
+				return &choicesv1.GetTopJokesResponse{}, nil
+			}
+			return &choicesv1.GetTopJokesResponse{
+				Entries: fallbackTopJokes(),
+			}, nil
+		}
+	}
+
+	resp := &choicesv1.GetTopJokesResponse{
+		Entries: make([]*choicesv1.TopJokesEntry, 0, pageSize),
+	}
+	for ; position < len(topJokes.Entries); position++ {
+		entry := &topJokes.Entries[position]
+		if !matchTopJoke(req, entry) {
+			continue
+		}
+		if len(resp.Entries) == pageSize {
+			resp.NextPageToken = encodeTopJokesPageToken(topJokes.ID, position)
+			break
+		}
+		resp.Entries = append(resp.Entries, &choicesv1.TopJokesEntry{
+			Rank:         uint64(position + 1),
+			Text:         entry.Text,
+			JokeId:       entry.JokeID,
+			ThemeId:      entry.ThemeID,
+			Theme:        entry.Theme,
+			Model:        entry.Model,
+			Policy:       entry.Policy,
+			Score:        entry.Score,
+			ScoreCiLower: entry.ScoreCiLower,
+			ScoreCiUpper: entry.ScoreCiUpper,
+			Votes:        uint64(entry.Votes),
+		})
+	}
+
+	return resp, nil
 }
 
 func (s *Server) Close() error {
-	return s.firestoreClient.Close()
+	return s.storage.Close()
 }
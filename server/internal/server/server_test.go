@@ -0,0 +1,449 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	choicesv1 "github.com/SaveTheRbtz/humor/gen/go/proto"
+	"github.com/SaveTheRbtz/humor/server/internal/metrics"
+	"github.com/SaveTheRbtz/humor/server/internal/sampler"
+	"github.com/SaveTheRbtz/humor/server/internal/storage"
+	"github.com/SaveTheRbtz/humor/server/internal/storage/memstore"
+)
+
+// newTestServer returns a server backed by a memstore holding one theme with
+// two jokes from each of two models.
+func newTestServer(t *testing.T, opts Options) (*Server, *memstore.Store) {
+	t.Helper()
+	ctx := context.Background()
+
+	store, err := memstore.NewStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	themeID, err := store.AddTheme(ctx, storage.Theme{Text: "Cats", Active: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, joke := range []storage.Joke{
+		{Text: "a1", Model: "model-a", Policy: "p", ThemeSet: "s"},
+		{Text: "a2", Model: "model-a", Policy: "p", ThemeSet: "s"},
+		{Text: "b1", Model: "model-b", Policy: "p", ThemeSet: "s"},
+		{Text: "b2", Model: "model-b", Policy: "p", ThemeSet: "s"},
+	} {
+		joke.Theme, joke.ThemeID, joke.Active = "Cats", themeID, true
+		if _, err := store.AddJoke(ctx, joke); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if opts.CacheTTL == 0 {
+		opts.CacheTTL = time.Minute
+	}
+	pairSampler, err := sampler.New(sampler.Uniform, store, zap.NewNop(), opts.CacheTTL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := NewServer(store, pairSampler, zap.NewNop(), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s, store
+}
+
+func rateRequest(id, session string, winner choicesv1.Winner) *choicesv1.RateChoicesRequest {
+	return &choicesv1.RateChoicesRequest{
+		Id:        id,
+		SessionId: session,
+		Winner:    winner,
+		Known:     choicesv1.Winner_NONE,
+	}
+}
+
+func wantCode(t *testing.T, err error, code codes.Code) {
+	t.Helper()
+	if got := status.Code(err); got != code {
+		t.Fatalf("got code %s, want %s: %v", got, code, err)
+	}
+}
+
+func TestGetChoicesRateChoices(t *testing.T) {
+	ctx := context.Background()
+	s, store := newTestServer(t, Options{ChoiceExpiry: time.Hour})
+
+	choices, err := s.GetChoices(ctx, &choicesv1.GetChoicesRequest{SessionId: "session"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if choices.Theme != "Cats" || choices.LeftJoke == "" || choices.RightJoke == "" || choices.LeftJoke == choices.RightJoke {
+		t.Fatalf("unexpected choices: %v", choices)
+	}
+
+	resp, err := s.RateChoices(ctx, rateRequest(choices.Id, "session", choicesv1.Winner_LEFT))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, info := range []*choicesv1.JokeInfo{resp.LeftJoke, resp.RightJoke} {
+		if info.GetModel() != "model-a" && info.GetModel() != "model-b" {
+			t.Errorf("revealed model = %q, want a model name", info.GetModel())
+		}
+	}
+
+	stored, err := store.GetChoice(ctx, choices.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.Winner == nil || *stored.Winner != choicesv1.Winner_LEFT || stored.RatedAt == nil {
+		t.Fatalf("vote not stored: %+v", stored)
+	}
+	ratedAt := *stored.RatedAt
+
+	// Re-submitting the same vote succeeds without rewriting it.
+	if _, err := s.RateChoices(ctx, rateRequest(choices.Id, "session", choicesv1.Winner_LEFT)); err != nil {
+		t.Fatalf("identical re-submission: %v", err)
+	}
+	stored, err = store.GetChoice(ctx, choices.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !stored.RatedAt.Equal(ratedAt) {
+		t.Errorf("re-submission changed the rating time: %v != %v", stored.RatedAt, ratedAt)
+	}
+}
+
+func TestRateChoicesErrors(t *testing.T) {
+	ctx := context.Background()
+	s, store := newTestServer(t, Options{ChoiceExpiry: time.Hour})
+
+	serve := func() string {
+		t.Helper()
+		choices, err := s.GetChoices(ctx, &choicesv1.GetChoicesRequest{SessionId: "session"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return choices.Id
+	}
+
+	t.Run("AlreadyRated", func(t *testing.T) {
+		id := serve()
+		if _, err := s.RateChoices(ctx, rateRequest(id, "session", choicesv1.Winner_LEFT)); err != nil {
+			t.Fatal(err)
+		}
+		_, err := s.RateChoices(ctx, rateRequest(id, "session", choicesv1.Winner_RIGHT))
+		wantCode(t, err, codes.AlreadyExists)
+	})
+
+	t.Run("SessionMismatch", func(t *testing.T) {
+		id := serve()
+		_, err := s.RateChoices(ctx, rateRequest(id, "other", choicesv1.Winner_LEFT))
+		wantCode(t, err, codes.PermissionDenied)
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		id := serve()
+		choice, err := store.GetChoice(ctx, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		choice.CreatedAt = time.Now().Add(-2 * time.Hour)
+		if err := store.SaveChoice(ctx, id, *choice); err != nil {
+			t.Fatal(err)
+		}
+		_, err = s.RateChoices(ctx, rateRequest(id, "session", choicesv1.Winner_LEFT))
+		wantCode(t, err, codes.FailedPrecondition)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		_, err := s.RateChoices(ctx, rateRequest("missing", "session", choicesv1.Winner_LEFT))
+		wantCode(t, err, codes.NotFound)
+	})
+
+	t.Run("InvalidArgument", func(t *testing.T) {
+		id := serve()
+		for _, req := range []*choicesv1.RateChoicesRequest{
+			rateRequest("", "session", choicesv1.Winner_LEFT),
+			rateRequest(id, "session", choicesv1.Winner_UNSPECIFIED),
+			{Id: id, SessionId: "session", Winner: choicesv1.Winner_LEFT},
+			rateRequest(id, "session", choicesv1.Winner(42)),
+		} {
+			_, err := s.RateChoices(ctx, req)
+			wantCode(t, err, codes.InvalidArgument)
+		}
+	})
+}
+
+func TestGetChoicesTheme(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestServer(t, Options{})
+
+	_, err := s.GetChoices(ctx, &choicesv1.GetChoicesRequest{Theme: "Dogs"})
+	wantCode(t, err, codes.NotFound)
+
+	_, err = s.GetChoices(ctx, &choicesv1.GetChoicesRequest{Theme: "Cats", ThemeSet: "other"})
+	wantCode(t, err, codes.FailedPrecondition)
+
+	choices, err := s.GetChoices(ctx, &choicesv1.GetChoicesRequest{Theme: "Cats", ThemeSet: "s"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if choices.Theme != "Cats" {
+		t.Errorf("theme = %q, want Cats", choices.Theme)
+	}
+}
+
+func TestRateChoicesReveal(t *testing.T) {
+	ctx := context.Background()
+	s, store := newTestServer(t, Options{ChoiceExpiry: time.Hour})
+
+	// The snapshot taken when the choice was served wins over the joke.
+	choices, err := s.GetChoices(ctx, &choicesv1.GetChoicesRequest{SessionId: "session"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	choice, err := store.GetChoice(ctx, choices.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	choice.LeftJoke.Model = "snapshot"
+	if err := store.SaveChoice(ctx, choices.Id, *choice); err != nil {
+		t.Fatal(err)
+	}
+	resp, err := s.RateChoices(ctx, rateRequest(choices.Id, "session", choicesv1.Winner_LEFT))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := resp.LeftJoke.GetModel(); got != "snapshot" {
+		t.Errorf("revealed model = %q, want the snapshot", got)
+	}
+
+	// Choices without snapshots read the jokes.
+	themeID := choice.ThemeID
+	unsnapshotted, err := store.AddJoke(ctx, storage.Joke{
+		Theme: "Cats", ThemeID: themeID, Text: "c1", Model: "model-c", Policy: "p", ThemeSet: "s",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	noWinner := choicesv1.Winner_UNSPECIFIED
+	if err := store.SaveChoice(ctx, "legacy", storage.Choice{
+		ThemeID:     themeID,
+		SessionID:   "session",
+		LeftJokeID:  unsnapshotted,
+		RightJokeID: choice.RightJokeID,
+		CreatedAt:   time.Now(),
+		Winner:      &noWinner,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	votes := testutil.ToFloat64(metrics.ModelVotes.WithLabelValues("model-c"))
+	resp, err = s.RateChoices(ctx, rateRequest("legacy", "session", choicesv1.Winner_RIGHT))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := resp.LeftJoke.GetModel(); got != "model-c" {
+		t.Errorf("revealed model = %q, want model-c", got)
+	}
+	if resp.LeftJoke.GetPolicy() != "p" || resp.RightJoke.GetModel() == "" {
+		t.Errorf("unexpected reveal: %v", resp)
+	}
+	if got := testutil.ToFloat64(metrics.ModelVotes.WithLabelValues("model-c")) - votes; got != 1 {
+		t.Errorf("model-c votes = %v, want 1", got)
+	}
+}
+
+func TestNewFilter(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestServer(t, Options{})
+
+	start := time.Date(2025, 8, 12, 13, 30, 0, 0, time.UTC)
+	filter, err := s.newFilter(ctx, "s", "p", timestamppb.New(start), timestamppb.New(start.Add(time.Hour)), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	day := time.Date(2025, 8, 12, 0, 0, 0, 0, time.UTC)
+	if !filter.Start.Equal(day) || !filter.End.Equal(day.Add(24*time.Hour)) {
+		t.Errorf("range = [%v, %v), want the whole day of %v", filter.Start, filter.End, day)
+	}
+	filter, err = s.newFilter(ctx, "", "", timestamppb.New(day), timestamppb.New(day.Add(24*time.Hour)), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !filter.Start.Equal(day) || !filter.End.Equal(day.Add(24*time.Hour)) {
+		t.Errorf("range = [%v, %v), want whole days kept", filter.Start, filter.End)
+	}
+	if filter, err := s.newFilter(ctx, "", "", nil, nil, false); err != nil || !filter.IsZero() {
+		t.Errorf("empty filter = %+v, %v", filter, err)
+	}
+
+	_, err = s.newFilter(ctx, "unknown", "", nil, nil, false)
+	wantCode(t, err, codes.InvalidArgument)
+	_, err = s.newFilter(ctx, "", "unknown", nil, nil, false)
+	wantCode(t, err, codes.InvalidArgument)
+	_, err = s.newFilter(ctx, "", "", timestamppb.New(start), timestamppb.New(start), false)
+	wantCode(t, err, codes.InvalidArgument)
+}
+
+func TestFilteredLeaderboards(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestServer(t, Options{})
+
+	for range 10 {
+		choices, err := s.GetChoices(ctx, &choicesv1.GetChoicesRequest{SessionId: "session"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := s.RateChoices(ctx, rateRequest(choices.Id, "session", choicesv1.Winner_LEFT)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	board, err := s.GetLeaderboard(ctx, &choicesv1.GetLeaderboardRequest{ThemeSet: "s"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(board.Entries) != 2 {
+		t.Errorf("leaderboard has %d entries, want 2", len(board.Entries))
+	}
+
+	_, err = s.GetLeaderboard(ctx, &choicesv1.GetLeaderboardRequest{ThemeSet: "unknown"})
+	wantCode(t, err, codes.InvalidArgument)
+}
+
+func TestFilteredHeadToHead(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestServer(t, Options{})
+
+	for range 10 {
+		choices, err := s.GetChoices(ctx, &choicesv1.GetChoicesRequest{SessionId: "session"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := s.RateChoices(ctx, rateRequest(choices.Id, "session", choicesv1.Winner_LEFT)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	matrix, err := s.GetHeadToHeadMatrix(ctx, &choicesv1.GetHeadToHeadMatrixRequest{Policy: "p"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(matrix.Pairs) != 1 || matrix.Pairs[0].Record.Votes == 0 {
+		t.Fatalf("unexpected head-to-head matrix: %v", matrix)
+	}
+	pair, err := s.GetHeadToHead(ctx, &choicesv1.GetHeadToHeadRequest{ModelA: "model-b", ModelB: "model-a", ThemeSet: "s"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pair.HeadToHead.ModelA != "model-b" || pair.HeadToHead.Record.Votes != matrix.Pairs[0].Record.Votes {
+		t.Errorf("unexpected head-to-head: %v", pair)
+	}
+
+	_, err = s.GetHeadToHead(ctx, &choicesv1.GetHeadToHeadRequest{ModelA: "model-a", ModelB: "model-b", Policy: "unknown"})
+	wantCode(t, err, codes.InvalidArgument)
+}
+
+func TestFilteredPositionBias(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestServer(t, Options{})
+
+	for range 10 {
+		choices, err := s.GetChoices(ctx, &choicesv1.GetChoicesRequest{SessionId: "session"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := s.RateChoices(ctx, rateRequest(choices.Id, "session", choicesv1.Winner_LEFT)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	start := time.Now().Add(-time.Hour)
+	report, err := s.GetPositionBias(ctx, &choicesv1.GetPositionBiasRequest{
+		ThemeSet:  "s",
+		StartTime: timestamppb.New(start),
+		EndTime:   timestamppb.New(start.Add(2 * time.Hour)),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Left.Wins == 0 || report.Left.Losses != 0 {
+		t.Errorf("left record = %v, want only wins", report.Left)
+	}
+
+	_, err = s.GetPositionBias(ctx, &choicesv1.GetPositionBiasRequest{Policy: "unknown"})
+	wantCode(t, err, codes.InvalidArgument)
+}
+
+func TestGetTopJokesModel(t *testing.T) {
+	ctx := context.Background()
+	s, store := newTestServer(t, Options{})
+
+	if _, err := store.AddTopJokes(ctx, storage.TopJokes{Entries: []storage.TopJoke{
+		{JokeID: "1", Text: "a1", Model: "model-a"},
+		{JokeID: "2", Text: "b1", Model: "model-b"},
+		{JokeID: "3", Text: "a2", Model: "model-a"},
+	}}); err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := s.GetTopJokes(ctx, &choicesv1.GetTopJokesRequest{Model: "model-a"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Entries) != 2 || resp.Entries[0].Rank != 1 || resp.Entries[1].Rank != 3 {
+		t.Fatalf("unexpected top jokes: %v", resp.Entries)
+	}
+	for _, entry := range resp.Entries {
+		if entry.Model != "model-a" {
+			t.Errorf("model = %q, want model-a", entry.Model)
+		}
+	}
+	resp, err = s.GetTopJokes(ctx, &choicesv1.GetTopJokesRequest{Model: "model-c"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Entries) != 0 {
+		t.Errorf("unknown model matched %d jokes", len(resp.Entries))
+	}
+}
+
+func TestListLeaderboardSnapshotsTies(t *testing.T) {
+	ctx := context.Background()
+	s, store := newTestServer(t, Options{})
+
+	createdAt := time.Now().Add(-time.Hour)
+	for range 3 {
+		if _, err := store.AddLeaderboard(ctx, storage.Leaderboard{CreatedAt: createdAt}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	listed := make(map[string]bool)
+	req := &choicesv1.ListLeaderboardSnapshotsRequest{PageSize: 1}
+	for range 4 {
+		resp, err := s.ListLeaderboardSnapshots(ctx, req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, snapshot := range resp.Snapshots {
+			listed[snapshot.Id] = true
+		}
+		if resp.NextPageToken == "" {
+			break
+		}
+		req.PageToken = resp.NextPageToken
+	}
+	if len(listed) != 3 {
+		t.Errorf("listed %d snapshots created at the same time, want 3", len(listed))
+	}
+
+	req.PageToken = "not a token"
+	_, err := s.ListLeaderboardSnapshots(ctx, req)
+	wantCode(t, err, codes.InvalidArgument)
+}
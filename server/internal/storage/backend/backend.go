@@ -0,0 +1,115 @@
+// Package backend opens the storage.Storage implementation selected at
+// startup.
+package backend
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/firestore"
+
+	"github.com/SaveTheRbtz/humor/server/internal/storage"
+	"github.com/SaveTheRbtz/humor/server/internal/storage/firestorestore"
+	"github.com/SaveTheRbtz/humor/server/internal/storage/memstore"
+	"github.com/SaveTheRbtz/humor/server/internal/storage/sqlitestore"
+)
+
+const (
+	Firestore = "firestore"
+	SQLite    = "sqlite"
+	Memory    = "memory"
+)
+
+type Options struct {
+	// Backend is one of Firestore, SQLite or Memory.
+	Backend string
+	// FirestoreProject is the GCP project of the Firestore database.
+	FirestoreProject string
+	// FirestoreSampling is the random theme sampling mode of Firestore.
+	FirestoreSampling string
+	// FirestoreCacheTTL is how long the cached sampling mode keeps the
+	// themes.
+	FirestoreCacheTTL time.Duration
+	// SQLitePath is the path to the SQLite database file.
+	SQLitePath string
+}
+
+// RegisterFlags binds the options to command line flags.
+func (o *Options) RegisterFlags(fs *flag.FlagSet) {
+	fs.StringVar(&o.Backend, "storage", Firestore, "storage backend: firestore, sqlite or memory")
+	fs.StringVar(&o.FirestoreProject, "firestore-project", "humor-arena", "Firestore project ID")
+	fs.StringVar(&o.FirestoreSampling, "firestore-sampling", string(firestorestore.SampleCached),
+		"Firestore random theme sampling mode: "+strings.Join(samplingModes(), ", "))
+	fs.DurationVar(&o.FirestoreCacheTTL, "firestore-cache-ttl", time.Minute,
+		"how long the cached Firestore sampling mode keeps the themes")
+	fs.StringVar(&o.SQLitePath, "sqlite-path", "humor-arena.db", "path to the SQLite database")
+}
+
+// Validate checks that the options describe a backend that can be opened.
+func (o *Options) Validate() error {
+	switch o.Backend {
+	case Firestore:
+		var errs []error
+		if o.FirestoreProject == "" {
+			errs = append(errs, errors.New("Firestore project is required"))
+		}
+		if !slices.Contains(firestorestore.SamplingModes, firestorestore.SamplingMode(o.FirestoreSampling)) {
+			errs = append(errs, fmt.Errorf("unknown Firestore sampling mode: %q", o.FirestoreSampling))
+		}
+		if o.FirestoreCacheTTL < 0 {
+			errs = append(errs, fmt.Errorf("Firestore cache TTL must not be negative: %s", o.FirestoreCacheTTL))
+		}
+		return errors.Join(errs...)
+	case SQLite:
+		if o.SQLitePath == "" {
+			return errors.New("SQLite path is required")
+		}
+		return nil
+	case Memory:
+		return nil
+	default:
+		return fmt.Errorf("unknown storage backend: %q", o.Backend)
+	}
+}
+
+// SamplesThemes reports whether the backend is configured to sample random
+// themes with a query of its own rather than from a list of all themes.
+func (o *Options) SamplesThemes() bool {
+	return o.Backend == Firestore && firestorestore.SamplingMode(o.FirestoreSampling) == firestorestore.SampleRandomField
+}
+
+func samplingModes() []string {
+	modes := make([]string, len(firestorestore.SamplingModes))
+	for i, mode := range firestorestore.SamplingModes {
+		modes[i] = string(mode)
+	}
+	return modes
+}
+
+// Open creates the storage backend described by the options.
+func Open(ctx context.Context, opts Options) (storage.Storage, error) {
+	switch opts.Backend {
+	case Firestore:
+		firestoreClient, err := firestore.NewClient(ctx, opts.FirestoreProject)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create Firestore client: %w", err)
+		}
+		store, err := firestorestore.NewStore(firestoreClient, firestorestore.SamplingMode(opts.FirestoreSampling), opts.FirestoreCacheTTL)
+		if err != nil {
+			firestoreClient.Close()
+			return nil, err
+		}
+		return store, nil
+	case SQLite:
+		return sqlitestore.NewStore(ctx, opts.SQLitePath)
+	case Memory:
+		return memstore.NewStore()
+	default:
+		return nil, fmt.Errorf("unknown storage backend: %q", opts.Backend)
+	}
+}
@@ -0,0 +1,464 @@
+package firestorestore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	choicesv1 "github.com/SaveTheRbtz/humor/gen/go/proto"
+	"github.com/SaveTheRbtz/humor/server/internal/storage"
+)
+
+type Store struct {
+	firestoreClient *firestore.Client
+	themeGetter     *randomDocumentGetterImpl[storage.Theme]
+}
+
+var _ storage.Storage = (*Store)(nil)
+
+// NewStore creates a Firestore backed store that samples random themes with
+// the given mode. SampleCached reloads the themes after cacheTime.
+func NewStore(firestoreClient *firestore.Client, sampling SamplingMode, cacheTime time.Duration) (*Store, error) {
+	randomThemeGetter, err := NewRandomDocumentGetter(
+		firestoreClient,
+		firestoreClient.Collection("themes").Query,
+		RandomDocumentOptions[storage.Theme]{
+			Mode:      sampling,
+			CacheTime: cacheTime,
+			Predicate: func(theme storage.Theme) bool { return theme.Active },
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create random theme getter: %w", err)
+	}
+
+	return &Store{
+		firestoreClient: firestoreClient,
+		themeGetter:     randomThemeGetter,
+	}, nil
+}
+
+func (s *Store) GetRandomThemes(ctx context.Context, limit int) ([]storage.Theme, error) {
+	themes, themeDocs, err := s.themeGetter.GetRandomDocuments(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+	for i := range themes {
+		themes[i].ID = themeDocs[i].Ref.ID
+	}
+	return themes, nil
+}
+
+func (s *Store) ListActiveThemes(ctx context.Context) ([]storage.Theme, error) {
+	themeDocs, err := s.firestoreClient.Collection("themes").Where("active", "==", true).Documents(ctx).GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get themes: %w", err)
+	}
+
+	themes := make([]storage.Theme, len(themeDocs))
+	for i, doc := range themeDocs {
+		if err := doc.DataTo(&themes[i]); err != nil {
+			return nil, fmt.Errorf("failed to parse theme document %s: %w", doc.Ref.ID, err)
+		}
+		themes[i].ID = doc.Ref.ID
+	}
+	return themes, nil
+}
+
+func (s *Store) GetTheme(ctx context.Context, id string) (*storage.Theme, error) {
+	docSnap, err := s.firestoreClient.Collection("themes").Doc(id).Get(ctx)
+	if status.Code(err) == codes.NotFound {
+		return nil, fmt.Errorf("theme %s: %w", id, storage.ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get theme: %w", err)
+	}
+	var theme storage.Theme
+	if err := docSnap.DataTo(&theme); err != nil {
+		return nil, fmt.Errorf("failed to parse theme document: %w", err)
+	}
+	theme.ID = docSnap.Ref.ID
+	return &theme, nil
+}
+
+func (s *Store) GetThemeByText(ctx context.Context, text string) (*storage.Theme, error) {
+	docSnap, err := s.firestoreClient.Collection("themes").Where("text", "==", text).Limit(1).Documents(ctx).Next()
+	if errors.Is(err, iterator.Done) {
+		return nil, fmt.Errorf("theme %q: %w", text, storage.ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get theme: %w", err)
+	}
+	var theme storage.Theme
+	if err := docSnap.DataTo(&theme); err != nil {
+		return nil, fmt.Errorf("failed to parse theme document: %w", err)
+	}
+	theme.ID = docSnap.Ref.ID
+	return &theme, nil
+}
+
+func (s *Store) AddTheme(ctx context.Context, theme storage.Theme) (string, error) {
+	docRef, _, err := s.firestoreClient.Collection("themes").Add(ctx, theme)
+	if err != nil {
+		return "", fmt.Errorf("failed to add theme: %w", err)
+	}
+	return docRef.ID, nil
+}
+
+func (s *Store) GetActiveJokesByTheme(ctx context.Context, theme string) ([]storage.Joke, error) {
+	return activeJokes(ctx, s.firestoreClient.Collection("jokes").Query.Where("theme", "==", theme))
+}
+
+func (s *Store) GetActiveJokesByThemeAndModel(ctx context.Context, theme, model string) ([]storage.Joke, error) {
+	return activeJokes(ctx, s.firestoreClient.Collection("jokes").Query.
+		Where("theme", "==", theme).
+		Where("model", "==", model))
+}
+
+// activeJokes returns the active jokes among those matching the query.
+func activeJokes(ctx context.Context, query firestore.Query) ([]storage.Joke, error) {
+	allJokeDocs, err := query.Documents(ctx).GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get jokes: %w", err)
+	}
+
+	jokes := make([]storage.Joke, 0, len(allJokeDocs))
+	for _, doc := range allJokeDocs {
+		if active_raw, ok := doc.Data()["active"]; ok {
+			if active := active_raw.(bool); active {
+				var joke storage.Joke
+				if err := doc.DataTo(&joke); err != nil {
+					return nil, fmt.Errorf("failed to parse joke document: %w", err)
+				}
+				joke.ID = doc.Ref.ID
+				jokes = append(jokes, joke)
+			}
+		}
+	}
+	return jokes, nil
+}
+
+func (s *Store) GetJoke(ctx context.Context, id string) (*storage.Joke, error) {
+	docSnap, err := s.firestoreClient.Collection("jokes").Doc(id).Get(ctx)
+	if status.Code(err) == codes.NotFound {
+		return nil, fmt.Errorf("joke %s: %w", id, storage.ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get joke: %w", err)
+	}
+	var joke storage.Joke
+	if err := docSnap.DataTo(&joke); err != nil {
+		return nil, fmt.Errorf("failed to parse joke document: %w", err)
+	}
+	joke.ID = docSnap.Ref.ID
+	return &joke, nil
+}
+
+func (s *Store) ListActiveJokes(ctx context.Context) ([]storage.Joke, error) {
+	jokeDocs, err := s.firestoreClient.Collection("jokes").Where("active", "==", true).Documents(ctx).GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get jokes: %w", err)
+	}
+
+	jokes := make([]storage.Joke, len(jokeDocs))
+	for i, doc := range jokeDocs {
+		if err := doc.DataTo(&jokes[i]); err != nil {
+			return nil, fmt.Errorf("failed to parse joke document %s: %w", doc.Ref.ID, err)
+		}
+		jokes[i].ID = doc.Ref.ID
+	}
+	return jokes, nil
+}
+
+func (s *Store) AddJoke(ctx context.Context, joke storage.Joke) (string, error) {
+	docRef, _, err := s.firestoreClient.Collection("jokes").Add(ctx, joke)
+	if err != nil {
+		return "", fmt.Errorf("failed to add joke: %w", err)
+	}
+	return docRef.ID, nil
+}
+
+func (s *Store) SaveChoice(ctx context.Context, id string, choice storage.Choice) error {
+	_, err := s.firestoreClient.Collection("choices").Doc(id).Set(ctx, choice)
+	if err != nil {
+		return fmt.Errorf("failed to save choice: %w", err)
+	}
+	return nil
+}
+
+func (s *Store) GetChoice(ctx context.Context, id string) (*storage.Choice, error) {
+	docSnap, err := s.firestoreClient.Collection("choices").Doc(id).Get(ctx)
+	if status.Code(err) == codes.NotFound {
+		return nil, fmt.Errorf("choice %s: %w", id, storage.ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get choice: %w", err)
+	}
+	var choice storage.Choice
+	if err := docSnap.DataTo(&choice); err != nil {
+		return nil, fmt.Errorf("failed to parse choice document: %w", err)
+	}
+	choice.ID = docSnap.Ref.ID
+	return &choice, nil
+}
+
+func (s *Store) RateChoice(ctx context.Context, id string, vote storage.Vote, unrated *storage.Choice) (*storage.Choice, error) {
+	ref := s.firestoreClient.Collection("choices").Doc(id)
+	var choice storage.Choice
+	err := s.firestoreClient.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		docSnap, err := tx.Get(ref)
+		switch {
+		case status.Code(err) == codes.NotFound:
+			if unrated == nil {
+				return fmt.Errorf("choice %s: %w", id, storage.ErrNotFound)
+			}
+			choice = *unrated
+		case err != nil:
+			return fmt.Errorf("failed to get choice: %w", err)
+		default:
+			choice = storage.Choice{}
+			if err := docSnap.DataTo(&choice); err != nil {
+				return fmt.Errorf("failed to parse choice document: %w", err)
+			}
+		}
+		choice.ID = id
+
+		write, err := storage.CheckVote(&choice, vote)
+		if err != nil || !write {
+			return err
+		}
+		vote.Apply(&choice)
+		if docSnap == nil || !docSnap.Exists() {
+			return tx.Create(ref, choice)
+		}
+		return tx.Update(ref, []firestore.Update{
+			{
+				Path:  "winner",
+				Value: vote.Winner.Number(),
+			},
+			{
+				Path:  "known",
+				Value: vote.Known.Number(),
+			},
+			{
+				Path:  "rated_at",
+				Value: vote.RatedAt,
+			},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &choice, nil
+}
+
+func (s *Store) ListRatedChoices(ctx context.Context) ([]storage.Choice, error) {
+	rated := []int32{
+		int32(choicesv1.Winner_NONE),
+		int32(choicesv1.Winner_LEFT),
+		int32(choicesv1.Winner_RIGHT),
+		int32(choicesv1.Winner_BOTH),
+	}
+	choiceDocs, err := s.firestoreClient.Collection("choices").Where("winner", "in", rated).Documents(ctx).GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get choices: %w", err)
+	}
+
+	choices := make([]storage.Choice, len(choiceDocs))
+	for i, doc := range choiceDocs {
+		if err := doc.DataTo(&choices[i]); err != nil {
+			return nil, fmt.Errorf("failed to parse choice document %s: %w", doc.Ref.ID, err)
+		}
+		choices[i].ID = doc.Ref.ID
+	}
+	return choices, nil
+}
+
+func (s *Store) DeleteExpiredChoices(ctx context.Context, createdBefore time.Time) (int, error) {
+	// Filter by created_at client-side so that no composite index is needed.
+	unratedDocs, err := s.firestoreClient.Collection("choices").
+		Where("winner", "==", int32(choicesv1.Winner_UNSPECIFIED)).
+		Documents(ctx).GetAll()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get unrated choices: %w", err)
+	}
+
+	var bulkWriter *firestore.BulkWriter
+	jobs := make([]*firestore.BulkWriterJob, 0)
+	for _, doc := range unratedDocs {
+		createdAt, ok := doc.Data()["created_at"].(time.Time)
+		if !ok || !createdAt.Before(createdBefore) {
+			continue
+		}
+		if bulkWriter == nil {
+			bulkWriter = s.firestoreClient.BulkWriter(ctx)
+		}
+		job, err := bulkWriter.Delete(doc.Ref)
+		if err != nil {
+			bulkWriter.End()
+			return 0, fmt.Errorf("failed to delete choice %s: %w", doc.Ref.ID, err)
+		}
+		jobs = append(jobs, job)
+	}
+	if bulkWriter != nil {
+		bulkWriter.End()
+	}
+
+	deleted := 0
+	for _, job := range jobs {
+		if _, err := job.Results(); err != nil {
+			return deleted, fmt.Errorf("failed to delete expired choice: %w", err)
+		}
+		deleted++
+	}
+	return deleted, nil
+}
+
+// latest returns the most recently created document of a collection.
+func (s *Store) latest(ctx context.Context, collection string) (*firestore.DocumentSnapshot, error) {
+	query := s.firestoreClient.Collection(collection).OrderBy("created_at", firestore.Desc).Limit(1)
+	docSnap, err := query.Documents(ctx).Next()
+	if errors.Is(err, iterator.Done) {
+		return nil, fmt.Errorf("no %s documents: %w", collection, storage.ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s: %w", collection, err)
+	}
+	return docSnap, nil
+}
+
+func (s *Store) GetLatestLeaderboard(ctx context.Context) (*storage.Leaderboard, error) {
+	docSnap, err := s.latest(ctx, "leaderboard")
+	if err != nil {
+		return nil, err
+	}
+	var leaderboard storage.Leaderboard
+	if err := docSnap.DataTo(&leaderboard); err != nil {
+		return nil, fmt.Errorf("failed to parse leaderboard document: %w", err)
+	}
+	leaderboard.ID = docSnap.Ref.ID
+	return &leaderboard, nil
+}
+
+func (s *Store) GetLeaderboard(ctx context.Context, id string) (*storage.Leaderboard, error) {
+	docSnap, err := s.firestoreClient.Collection("leaderboard").Doc(id).Get(ctx)
+	if status.Code(err) == codes.NotFound {
+		return nil, fmt.Errorf("leaderboard %s: %w", id, storage.ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get leaderboard: %w", err)
+	}
+	var leaderboard storage.Leaderboard
+	if err := docSnap.DataTo(&leaderboard); err != nil {
+		return nil, fmt.Errorf("failed to parse leaderboard document: %w", err)
+	}
+	leaderboard.ID = docSnap.Ref.ID
+	return &leaderboard, nil
+}
+
+func (s *Store) ListLeaderboards(ctx context.Context, opts storage.ListLeaderboardsOptions) ([]storage.Leaderboard, error) {
+	query := s.firestoreClient.Collection("leaderboard").
+		OrderBy("created_at", firestore.Desc).
+		OrderBy(firestore.DocumentID, firestore.Desc)
+	if !opts.Start.IsZero() {
+		query = query.Where("created_at", ">=", opts.Start)
+	}
+	if !opts.End.IsZero() {
+		query = query.Where("created_at", "<", opts.End)
+	}
+	if opts.After != nil {
+		query = query.StartAfter(opts.After.CreatedAt, opts.After.ID)
+	}
+	if opts.Limit > 0 {
+		query = query.Limit(opts.Limit)
+	}
+	docs, err := query.Documents(ctx).GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list leaderboards: %w", err)
+	}
+
+	leaderboards := make([]storage.Leaderboard, len(docs))
+	for i, doc := range docs {
+		if err := doc.DataTo(&leaderboards[i]); err != nil {
+			return nil, fmt.Errorf("failed to parse leaderboard document %s: %w", doc.Ref.ID, err)
+		}
+		leaderboards[i].ID = doc.Ref.ID
+	}
+	return leaderboards, nil
+}
+
+func (s *Store) AddLeaderboard(ctx context.Context, leaderboard storage.Leaderboard) (string, error) {
+	docRef, _, err := s.firestoreClient.Collection("leaderboard").Add(ctx, leaderboard)
+	if err != nil {
+		return "", fmt.Errorf("failed to add leaderboard: %w", err)
+	}
+	return docRef.ID, nil
+}
+
+func (s *Store) GetLatestTopJokes(ctx context.Context) (*storage.TopJokes, error) {
+	docSnap, err := s.latest(ctx, "top_jokes")
+	if err != nil {
+		return nil, err
+	}
+	var topJokes storage.TopJokes
+	if err := docSnap.DataTo(&topJokes); err != nil {
+		return nil, fmt.Errorf("failed to parse top jokes document: %w", err)
+	}
+	topJokes.ID = docSnap.Ref.ID
+	return &topJokes, nil
+}
+
+func (s *Store) GetTopJokes(ctx context.Context, id string) (*storage.TopJokes, error) {
+	docSnap, err := s.firestoreClient.Collection("top_jokes").Doc(id).Get(ctx)
+	if status.Code(err) == codes.NotFound {
+		return nil, fmt.Errorf("top jokes %s: %w", id, storage.ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get top jokes: %w", err)
+	}
+	var topJokes storage.TopJokes
+	if err := docSnap.DataTo(&topJokes); err != nil {
+		return nil, fmt.Errorf("failed to parse top jokes document: %w", err)
+	}
+	topJokes.ID = docSnap.Ref.ID
+	return &topJokes, nil
+}
+
+func (s *Store) AddTopJokes(ctx context.Context, topJokes storage.TopJokes) (string, error) {
+	docRef, _, err := s.firestoreClient.Collection("top_jokes").Add(ctx, topJokes)
+	if err != nil {
+		return "", fmt.Errorf("failed to add top jokes: %w", err)
+	}
+	return docRef.ID, nil
+}
+
+func (s *Store) GetLatestModelWeights(ctx context.Context) (*storage.ModelWeights, error) {
+	docSnap, err := s.latest(ctx, "model_weights")
+	if err != nil {
+		return nil, err
+	}
+	var weights storage.ModelWeights
+	if err := docSnap.DataTo(&weights); err != nil {
+		return nil, fmt.Errorf("failed to parse model weights document: %w", err)
+	}
+	weights.ID = docSnap.Ref.ID
+	return &weights, nil
+}
+
+func (s *Store) AddModelWeights(ctx context.Context, weights storage.ModelWeights) (string, error) {
+	docRef, _, err := s.firestoreClient.Collection("model_weights").Add(ctx, weights)
+	if err != nil {
+		return "", fmt.Errorf("failed to add model weights: %w", err)
+	}
+	return docRef.ID, nil
+}
+
+func (s *Store) Close() error {
+	return s.firestoreClient.Close()
+}
@@ -0,0 +1,293 @@
+package firestorestore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	insecureRand "math/rand/v2"
+	"sync/atomic"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"gonum.org/v1/gonum/stat/sampleuv"
+	"google.golang.org/api/iterator"
+
+	"github.com/SaveTheRbtz/humor/server/internal/metrics"
+)
+
+// SamplingMode selects how a random document getter picks documents.
+type SamplingMode string
+
+const (
+	// SampleCached loads the whole query result, caches it for the cache
+	// time and shuffles it on every call.
+	SampleCached SamplingMode = "cached"
+	// SampleRandomField picks every document with a `random >= r` query that
+	// wraps around to the lowest `random` value, so the cost of a call does
+	// not depend on the collection size. A document is picked with
+	// probability proportional to the gap below its `random` value, which is
+	// uniform in expectation. With a Weight, a drawn document is kept with
+	// probability Weight/MaxWeight and otherwise drawn again.
+	SampleRandomField SamplingMode = "random-field"
+)
+
+// SamplingModes lists the supported sampling modes.
+var SamplingModes = []SamplingMode{SampleCached, SampleRandomField}
+
+// defaultMaxAttempts bounds the number of random-field draws per requested
+// document when RandomDocumentOptions.MaxAttempts is zero.
+const defaultMaxAttempts = 10
+
+// maxScan bounds the documents a random-field draw reads on each side of its
+// pivot while looking for one that satisfies the predicate. A draw that finds
+// none is retried with a new pivot.
+const maxScan = 20
+
+// errNoMatch is returned by a random-field draw that read documents but none
+// that satisfy the predicate.
+var errNoMatch = errors.New("no matching document near the pivot")
+
+// RandomDocumentOptions configures a random document getter.
+type RandomDocumentOptions[T any] struct {
+	// Mode defaults to SampleCached.
+	Mode SamplingMode
+	// CacheTime is how long SampleCached keeps the query result.
+	CacheTime time.Duration
+	// Predicate filters the decoded documents; nil accepts all of them.
+	// SampleRandomField applies it to at most maxScan documents per draw, so
+	// it should accept most documents of the query.
+	Predicate func(T) bool
+	// Weight returns the non-negative sampling weight of a document; nil
+	// samples uniformly.
+	Weight func(T) float64
+	// MaxWeight is an upper bound of Weight. SampleRandomField accepts a
+	// drawn document with probability Weight/MaxWeight.
+	MaxWeight float64
+	// MaxAttempts bounds the number of SampleRandomField draws per requested
+	// document, including duplicates, draws without a match and rejected
+	// draws.
+	MaxAttempts int
+}
+
+type documentCache[T any] struct {
+	docs      []*firestore.DocumentSnapshot
+	objs      []T
+	timestamp time.Time
+}
+
+type randomDocumentGetterImpl[T any] struct {
+	firestoreClient *firestore.Client
+	query           firestore.Query
+	opts            RandomDocumentOptions[T]
+	cache           atomic.Pointer[documentCache[T]]
+}
+
+func NewRandomDocumentGetter[T any](
+	firestoreClient *firestore.Client,
+	query firestore.Query,
+	opts RandomDocumentOptions[T],
+) (*randomDocumentGetterImpl[T], error) {
+	switch opts.Mode {
+	case "":
+		opts.Mode = SampleCached
+	case SampleCached, SampleRandomField:
+	default:
+		return nil, fmt.Errorf("unknown sampling mode: %q", opts.Mode)
+	}
+	if opts.Weight != nil && opts.Mode == SampleRandomField && opts.MaxWeight <= 0 {
+		return nil, fmt.Errorf("max weight must be positive for weighted %s sampling", opts.Mode)
+	}
+	if opts.MaxAttempts <= 0 {
+		opts.MaxAttempts = defaultMaxAttempts
+	}
+
+	return &randomDocumentGetterImpl[T]{
+		firestoreClient: firestoreClient,
+		query:           query,
+		opts:            opts,
+	}, nil
+}
+
+// GetRandomDocuments returns limit distinct random documents that satisfy
+// the predicate along with their snapshots.
+func (r *randomDocumentGetterImpl[T]) GetRandomDocuments(
+	ctx context.Context,
+	limit int,
+) ([]T, []*firestore.DocumentSnapshot, error) {
+	if r.opts.Mode == SampleRandomField {
+		return r.getRandomFieldDocuments(ctx, limit)
+	}
+	return r.getCachedDocuments(ctx, limit)
+}
+
+func (r *randomDocumentGetterImpl[T]) getCachedDocuments(
+	ctx context.Context,
+	limit int,
+) ([]T, []*firestore.DocumentSnapshot, error) {
+	cached := r.cache.Load()
+	hit := cached != nil && cached.timestamp.Add(r.opts.CacheTime).After(time.Now())
+	metrics.CacheLookup("firestore_random_documents", hit)
+	if !hit {
+		docs, err := r.query.Documents(ctx).GetAll()
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get documents: %w", err)
+		}
+		cached = &documentCache[T]{
+			docs:      make([]*firestore.DocumentSnapshot, 0, len(docs)),
+			objs:      make([]T, 0, len(docs)),
+			timestamp: time.Now(),
+		}
+		for _, doc := range docs {
+			obj, ok, err := r.decode(doc)
+			if err != nil {
+				return nil, nil, err
+			}
+			if ok {
+				cached.docs = append(cached.docs, doc)
+				cached.objs = append(cached.objs, obj)
+			}
+		}
+		if r.opts.CacheTime > 0 {
+			r.cache.Store(cached)
+		}
+	}
+
+	indices, err := r.sampleIndices(cached.objs, limit)
+	if err != nil {
+		return nil, nil, err
+	}
+	objs := make([]T, limit)
+	docs := make([]*firestore.DocumentSnapshot, limit)
+	for i, idx := range indices {
+		objs[i] = cached.objs[idx]
+		docs[i] = cached.docs[idx]
+	}
+	return objs, docs, nil
+}
+
+// sampleIndices picks limit distinct indices of objs, uniformly or in
+// proportion to their weights.
+func (r *randomDocumentGetterImpl[T]) sampleIndices(objs []T, limit int) ([]int, error) {
+	if r.opts.Weight == nil {
+		if len(objs) < limit {
+			return nil, fmt.Errorf("not enough documents found: %d < %d", len(objs), limit)
+		}
+		return insecureRand.Perm(len(objs))[:limit], nil
+	}
+
+	weights := make([]float64, len(objs))
+	var positive int
+	for i, obj := range objs {
+		weights[i] = max(r.opts.Weight(obj), 0)
+		if weights[i] > 0 {
+			positive++
+		}
+	}
+	if positive < limit {
+		return nil, fmt.Errorf("not enough documents found: %d < %d", positive, limit)
+	}
+	weighted := sampleuv.NewWeighted(weights, nil)
+	indices := make([]int, limit)
+	for i := range indices {
+		indices[i], _ = weighted.Take()
+	}
+	return indices, nil
+}
+
+func (r *randomDocumentGetterImpl[T]) getRandomFieldDocuments(
+	ctx context.Context,
+	limit int,
+) ([]T, []*firestore.DocumentSnapshot, error) {
+	objs := make([]T, 0, limit)
+	docs := make([]*firestore.DocumentSnapshot, 0, limit)
+	picked := make(map[string]bool, limit)
+	for attempt := 0; len(docs) < limit; attempt++ {
+		if attempt >= limit*r.opts.MaxAttempts {
+			return nil, nil, fmt.Errorf("not enough documents found: %d < %d", len(docs), limit)
+		}
+		obj, doc, err := r.next(ctx, insecureRand.Float64())
+		if errors.Is(err, errNoMatch) {
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		if doc == nil {
+			return nil, nil, fmt.Errorf("not enough documents found: %d < %d", 0, limit)
+		}
+		if picked[doc.Ref.Path] {
+			continue
+		}
+		if r.opts.Weight != nil && insecureRand.Float64()*r.opts.MaxWeight >= r.opts.Weight(obj) {
+			continue
+		}
+		picked[doc.Ref.Path] = true
+		objs = append(objs, obj)
+		docs = append(docs, doc)
+	}
+	return objs, docs, nil
+}
+
+// next returns the first document satisfying the predicate with a random
+// value of at least threshold, wrapping around to the lowest random value.
+// Only maxScan documents are read on each side of threshold; errNoMatch is
+// returned if none of them satisfies the predicate. It returns a nil
+// snapshot if the query has no documents.
+func (r *randomDocumentGetterImpl[T]) next(
+	ctx context.Context,
+	threshold float64,
+) (T, *firestore.DocumentSnapshot, error) {
+	obj, doc, above, err := r.first(ctx, r.query.Where("random", ">=", threshold))
+	if err != nil || doc != nil || above == maxScan {
+		return obj, doc, noMatch(doc, above, err)
+	}
+	obj, doc, below, err := r.first(ctx, r.query.Where("random", "<", threshold))
+	return obj, doc, noMatch(doc, above+below, err)
+}
+
+// noMatch turns a draw that read documents without finding one into
+// errNoMatch.
+func noMatch(doc *firestore.DocumentSnapshot, scanned int, err error) error {
+	if err == nil && doc == nil && scanned > 0 {
+		return errNoMatch
+	}
+	return err
+}
+
+// first returns the first of at most maxScan documents of the query that
+// satisfies the predicate, and the number of documents read.
+func (r *randomDocumentGetterImpl[T]) first(
+	ctx context.Context,
+	query firestore.Query,
+) (T, *firestore.DocumentSnapshot, int, error) {
+	var zero T
+	docs := query.OrderBy("random", firestore.Asc).Limit(maxScan).Documents(ctx)
+	defer docs.Stop()
+	for scanned := 0; ; scanned++ {
+		doc, err := docs.Next()
+		if errors.Is(err, iterator.Done) {
+			return zero, nil, scanned, nil
+		}
+		if err != nil {
+			return zero, nil, scanned, fmt.Errorf("failed to get documents: %w", err)
+		}
+		obj, ok, err := r.decode(doc)
+		if err != nil {
+			return zero, nil, scanned, err
+		}
+		if ok {
+			return obj, doc, scanned + 1, nil
+		}
+	}
+}
+
+func (r *randomDocumentGetterImpl[T]) decode(doc *firestore.DocumentSnapshot) (T, bool, error) {
+	var obj T
+	if err := doc.DataTo(&obj); err != nil {
+		return obj, false, fmt.Errorf("failed to decode document: %w", err)
+	}
+	if r.opts.Predicate != nil && !r.opts.Predicate(obj) {
+		return obj, false, nil
+	}
+	return obj, true, nil
+}
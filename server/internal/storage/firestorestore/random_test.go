@@ -0,0 +1,54 @@
+package firestorestore
+
+import "testing"
+
+func TestSampleIndices(t *testing.T) {
+	weights := []float64{0, 1, 3, -1}
+	r := &randomDocumentGetterImpl[float64]{opts: RandomDocumentOptions[float64]{
+		Weight: func(w float64) float64 { return w },
+	}}
+
+	counts := make([]int, len(weights))
+	for range 1000 {
+		indices, err := r.sampleIndices(weights, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(indices) != 2 || indices[0] == indices[1] {
+			t.Fatalf("sampleIndices = %v, want two distinct indices", indices)
+		}
+		for _, i := range indices {
+			counts[i]++
+		}
+	}
+	// Only the two documents with a positive weight are picked.
+	if counts[0] != 0 || counts[3] != 0 || counts[1] != 1000 || counts[2] != 1000 {
+		t.Errorf("picked %v times", counts)
+	}
+
+	// Single picks follow the weights.
+	counts = make([]int, len(weights))
+	for range 4000 {
+		indices, err := r.sampleIndices(weights, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		counts[indices[0]]++
+	}
+	if counts[0] != 0 || counts[3] != 0 || counts[2] < 2700 || counts[2] > 3300 {
+		t.Errorf("picked %v times, want about 1000 and 3000 for weights 1 and 3", counts)
+	}
+
+	if _, err := r.sampleIndices(weights, 3); err == nil {
+		t.Error("sampleIndices picked documents without weight")
+	}
+
+	r.opts.Weight = nil
+	indices, err := r.sampleIndices(weights, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(indices) != 4 {
+		t.Errorf("sampleIndices = %v, want all four documents", indices)
+	}
+}
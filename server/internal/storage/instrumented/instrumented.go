@@ -0,0 +1,208 @@
+// Package instrumented traces storage calls and records their latency.
+package instrumented
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/SaveTheRbtz/humor/server/internal/metrics"
+	"github.com/SaveTheRbtz/humor/server/internal/storage"
+)
+
+// Store wraps a storage backend, starts a span for every call and observes
+// its latency in metrics.StorageDuration.
+type Store struct {
+	store   storage.Storage
+	backend string
+}
+
+var _ storage.Storage = (*Store)(nil)
+
+// New wraps store, labeling its metrics with the backend name.
+func New(store storage.Storage, backend string) *Store {
+	return &Store{store: store, backend: backend}
+}
+
+var tracer = otel.Tracer("github.com/SaveTheRbtz/humor/server/internal/storage/instrumented")
+
+func observe[T any](ctx context.Context, s *Store, method string, call func(context.Context) (T, error)) (T, error) {
+	ctx, span := tracer.Start(ctx, "storage."+method, trace.WithAttributes(
+		attribute.String("storage.backend", s.backend),
+	))
+	defer span.End()
+
+	start := time.Now()
+	result, err := call(ctx)
+	outcome := "ok"
+	switch {
+	case errors.Is(err, storage.ErrNotFound):
+		outcome = "not_found"
+	case err != nil:
+		outcome = "error"
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	metrics.StorageDuration.WithLabelValues(s.backend, method, outcome).Observe(time.Since(start).Seconds())
+	return result, err
+}
+
+func observeErr(ctx context.Context, s *Store, method string, call func(context.Context) error) error {
+	_, err := observe(ctx, s, method, func(ctx context.Context) (struct{}, error) {
+		return struct{}{}, call(ctx)
+	})
+	return err
+}
+
+func (s *Store) GetRandomThemes(ctx context.Context, limit int) ([]storage.Theme, error) {
+	return observe(ctx, s, "GetRandomThemes", func(ctx context.Context) ([]storage.Theme, error) {
+		return s.store.GetRandomThemes(ctx, limit)
+	})
+}
+
+func (s *Store) ListActiveThemes(ctx context.Context) ([]storage.Theme, error) {
+	return observe(ctx, s, "ListActiveThemes", func(ctx context.Context) ([]storage.Theme, error) {
+		return s.store.ListActiveThemes(ctx)
+	})
+}
+
+func (s *Store) GetTheme(ctx context.Context, id string) (*storage.Theme, error) {
+	return observe(ctx, s, "GetTheme", func(ctx context.Context) (*storage.Theme, error) {
+		return s.store.GetTheme(ctx, id)
+	})
+}
+
+func (s *Store) GetThemeByText(ctx context.Context, text string) (*storage.Theme, error) {
+	return observe(ctx, s, "GetThemeByText", func(ctx context.Context) (*storage.Theme, error) {
+		return s.store.GetThemeByText(ctx, text)
+	})
+}
+
+func (s *Store) AddTheme(ctx context.Context, theme storage.Theme) (string, error) {
+	return observe(ctx, s, "AddTheme", func(ctx context.Context) (string, error) {
+		return s.store.AddTheme(ctx, theme)
+	})
+}
+
+func (s *Store) GetActiveJokesByTheme(ctx context.Context, theme string) ([]storage.Joke, error) {
+	return observe(ctx, s, "GetActiveJokesByTheme", func(ctx context.Context) ([]storage.Joke, error) {
+		return s.store.GetActiveJokesByTheme(ctx, theme)
+	})
+}
+
+func (s *Store) GetActiveJokesByThemeAndModel(ctx context.Context, theme, model string) ([]storage.Joke, error) {
+	return observe(ctx, s, "GetActiveJokesByThemeAndModel", func(ctx context.Context) ([]storage.Joke, error) {
+		return s.store.GetActiveJokesByThemeAndModel(ctx, theme, model)
+	})
+}
+
+func (s *Store) GetJoke(ctx context.Context, id string) (*storage.Joke, error) {
+	return observe(ctx, s, "GetJoke", func(ctx context.Context) (*storage.Joke, error) {
+		return s.store.GetJoke(ctx, id)
+	})
+}
+
+func (s *Store) ListActiveJokes(ctx context.Context) ([]storage.Joke, error) {
+	return observe(ctx, s, "ListActiveJokes", func(ctx context.Context) ([]storage.Joke, error) {
+		return s.store.ListActiveJokes(ctx)
+	})
+}
+
+func (s *Store) AddJoke(ctx context.Context, joke storage.Joke) (string, error) {
+	return observe(ctx, s, "AddJoke", func(ctx context.Context) (string, error) {
+		return s.store.AddJoke(ctx, joke)
+	})
+}
+
+func (s *Store) SaveChoice(ctx context.Context, id string, choice storage.Choice) error {
+	return observeErr(ctx, s, "SaveChoice", func(ctx context.Context) error {
+		return s.store.SaveChoice(ctx, id, choice)
+	})
+}
+
+func (s *Store) GetChoice(ctx context.Context, id string) (*storage.Choice, error) {
+	return observe(ctx, s, "GetChoice", func(ctx context.Context) (*storage.Choice, error) {
+		return s.store.GetChoice(ctx, id)
+	})
+}
+
+func (s *Store) RateChoice(ctx context.Context, id string, vote storage.Vote, unrated *storage.Choice) (*storage.Choice, error) {
+	return observe(ctx, s, "RateChoice", func(ctx context.Context) (*storage.Choice, error) {
+		return s.store.RateChoice(ctx, id, vote, unrated)
+	})
+}
+
+func (s *Store) ListRatedChoices(ctx context.Context) ([]storage.Choice, error) {
+	return observe(ctx, s, "ListRatedChoices", func(ctx context.Context) ([]storage.Choice, error) {
+		return s.store.ListRatedChoices(ctx)
+	})
+}
+
+func (s *Store) DeleteExpiredChoices(ctx context.Context, createdBefore time.Time) (int, error) {
+	return observe(ctx, s, "DeleteExpiredChoices", func(ctx context.Context) (int, error) {
+		return s.store.DeleteExpiredChoices(ctx, createdBefore)
+	})
+}
+
+func (s *Store) GetLatestLeaderboard(ctx context.Context) (*storage.Leaderboard, error) {
+	return observe(ctx, s, "GetLatestLeaderboard", func(ctx context.Context) (*storage.Leaderboard, error) {
+		return s.store.GetLatestLeaderboard(ctx)
+	})
+}
+
+func (s *Store) GetLeaderboard(ctx context.Context, id string) (*storage.Leaderboard, error) {
+	return observe(ctx, s, "GetLeaderboard", func(ctx context.Context) (*storage.Leaderboard, error) {
+		return s.store.GetLeaderboard(ctx, id)
+	})
+}
+
+func (s *Store) ListLeaderboards(ctx context.Context, opts storage.ListLeaderboardsOptions) ([]storage.Leaderboard, error) {
+	return observe(ctx, s, "ListLeaderboards", func(ctx context.Context) ([]storage.Leaderboard, error) {
+		return s.store.ListLeaderboards(ctx, opts)
+	})
+}
+
+func (s *Store) AddLeaderboard(ctx context.Context, leaderboard storage.Leaderboard) (string, error) {
+	return observe(ctx, s, "AddLeaderboard", func(ctx context.Context) (string, error) {
+		return s.store.AddLeaderboard(ctx, leaderboard)
+	})
+}
+
+func (s *Store) GetLatestTopJokes(ctx context.Context) (*storage.TopJokes, error) {
+	return observe(ctx, s, "GetLatestTopJokes", func(ctx context.Context) (*storage.TopJokes, error) {
+		return s.store.GetLatestTopJokes(ctx)
+	})
+}
+
+func (s *Store) GetTopJokes(ctx context.Context, id string) (*storage.TopJokes, error) {
+	return observe(ctx, s, "GetTopJokes", func(ctx context.Context) (*storage.TopJokes, error) {
+		return s.store.GetTopJokes(ctx, id)
+	})
+}
+
+func (s *Store) AddTopJokes(ctx context.Context, topJokes storage.TopJokes) (string, error) {
+	return observe(ctx, s, "AddTopJokes", func(ctx context.Context) (string, error) {
+		return s.store.AddTopJokes(ctx, topJokes)
+	})
+}
+
+func (s *Store) GetLatestModelWeights(ctx context.Context) (*storage.ModelWeights, error) {
+	return observe(ctx, s, "GetLatestModelWeights", func(ctx context.Context) (*storage.ModelWeights, error) {
+		return s.store.GetLatestModelWeights(ctx)
+	})
+}
+
+func (s *Store) AddModelWeights(ctx context.Context, weights storage.ModelWeights) (string, error) {
+	return observe(ctx, s, "AddModelWeights", func(ctx context.Context) (string, error) {
+		return s.store.AddModelWeights(ctx, weights)
+	})
+}
+
+func (s *Store) Close() error {
+	return s.store.Close()
+}
@@ -0,0 +1,245 @@
+// Package jokeindex keeps active themes and jokes in memory so that picking
+// a pair of jokes does not read the database.
+package jokeindex
+
+import (
+	"context"
+	"fmt"
+	insecureRand "math/rand/v2"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/SaveTheRbtz/humor/server/internal/storage"
+)
+
+// defaultMinRefreshInterval is the default of Options.MinRefreshInterval.
+const defaultMinRefreshInterval = time.Minute
+
+// Options configures a Store.
+type Options struct {
+	// Interval is the time between two refreshes of the index.
+	Interval time.Duration
+	// MinRefreshInterval is the minimum time between a refresh and one
+	// triggered by a lookup that missed the index. Defaults to a minute.
+	MinRefreshInterval time.Duration
+	// StorageRandomThemes passes GetRandomThemes through to the wrapped
+	// storage, for backends that are configured to sample themes themselves.
+	StorageRandomThemes bool
+}
+
+// themeModel is the key of the jokes of a model for a theme.
+type themeModel struct {
+	themeID string
+	model   string
+}
+
+// index is an immutable snapshot of the active themes and jokes.
+type index struct {
+	loadedAt     time.Time
+	themes       []storage.Theme
+	themesByID   map[string]*storage.Theme
+	themesByText map[string]*storage.Theme
+	// jokes maps theme IDs to active jokes.
+	jokes map[string][]storage.Joke
+	// jokesByModel holds the same jokes split by model.
+	jokesByModel map[themeModel][]storage.Joke
+}
+
+// Store serves GetRandomThemes, ListActiveThemes, GetTheme, GetThemeByText,
+// GetActiveJokesByTheme and GetActiveJokesByThemeAndModel from an index of active themes and jokes that is
+// refreshed in the background and swapped atomically. Everything else, and
+// lookups of themes missing from the index, go to the wrapped storage. New
+// themes and jokes become visible after the next refresh, which comes early
+// when a lookup finds an active theme or jokes that the index is missing.
+type Store struct {
+	storage.Storage
+
+	logger *zap.Logger
+	opts   Options
+	index  atomic.Pointer[index]
+	// stale requests an early refresh.
+	stale chan struct{}
+
+	cancel context.CancelFunc
+	done   sync.WaitGroup
+}
+
+var _ storage.Storage = (*Store)(nil)
+
+// New loads the index and keeps refreshing it every interval until Close.
+func New(ctx context.Context, store storage.Storage, logger *zap.Logger, opts Options) (*Store, error) {
+	if opts.MinRefreshInterval <= 0 {
+		opts.MinRefreshInterval = defaultMinRefreshInterval
+	}
+	s := &Store{
+		Storage: store,
+		logger:  logger,
+		opts:    opts,
+		stale:   make(chan struct{}, 1),
+	}
+	if err := s.Refresh(ctx); err != nil {
+		return nil, err
+	}
+
+	refreshCtx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	s.done.Add(1)
+	go s.run(refreshCtx)
+
+	return s, nil
+}
+
+func (s *Store) run(ctx context.Context) {
+	defer s.done.Done()
+
+	ticker := time.NewTicker(s.opts.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-s.stale:
+			if time.Since(s.index.Load().loadedAt) < s.opts.MinRefreshInterval {
+				continue
+			}
+		}
+		// On failure the previous index stays in use.
+		if err := s.Refresh(ctx); err != nil {
+			s.logger.Warn("Failed to refresh joke index", zap.Error(err))
+		}
+	}
+}
+
+// markStale requests an early refresh without waiting for it.
+func (s *Store) markStale() {
+	select {
+	case s.stale <- struct{}{}:
+	default:
+	}
+}
+
+// Refresh reloads the index from the wrapped storage.
+func (s *Store) Refresh(ctx context.Context) error {
+	themes, err := s.Storage.ListActiveThemes(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list themes: %w", err)
+	}
+	jokes, err := s.Storage.ListActiveJokes(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list jokes: %w", err)
+	}
+
+	idx := &index{
+		loadedAt:     time.Now(),
+		themes:       themes,
+		themesByID:   make(map[string]*storage.Theme, len(themes)),
+		themesByText: make(map[string]*storage.Theme, len(themes)),
+		jokes:        make(map[string][]storage.Joke, len(themes)),
+		jokesByModel: make(map[themeModel][]storage.Joke),
+	}
+	for i := range themes {
+		idx.themesByID[themes[i].ID] = &themes[i]
+		idx.themesByText[themes[i].Text] = &themes[i]
+	}
+	skipped := 0
+	for _, joke := range jokes {
+		theme, ok := idx.themesByID[joke.ThemeID]
+		if !ok {
+			// Older jokes may only have the theme text.
+			theme, ok = idx.themesByText[joke.Theme]
+		}
+		if !ok {
+			skipped++
+			continue
+		}
+		idx.jokes[theme.ID] = append(idx.jokes[theme.ID], joke)
+		key := themeModel{themeID: theme.ID, model: joke.Model}
+		idx.jokesByModel[key] = append(idx.jokesByModel[key], joke)
+	}
+	s.index.Store(idx)
+
+	s.logger.Debug("Joke index refreshed",
+		zap.Int("themes", len(themes)),
+		zap.Int("jokes", len(jokes)-skipped),
+		zap.Int("skipped", skipped),
+	)
+	return nil
+}
+
+func (s *Store) GetRandomThemes(ctx context.Context, limit int) ([]storage.Theme, error) {
+	if s.opts.StorageRandomThemes {
+		return s.Storage.GetRandomThemes(ctx, limit)
+	}
+	idx := s.index.Load()
+	if len(idx.themes) < limit {
+		return nil, fmt.Errorf("not enough documents found: %d < %d", len(idx.themes), limit)
+	}
+	themes := make([]storage.Theme, limit)
+	for i, j := range insecureRand.Perm(len(idx.themes))[:limit] {
+		themes[i] = idx.themes[j]
+	}
+	return themes, nil
+}
+
+func (s *Store) ListActiveThemes(ctx context.Context) ([]storage.Theme, error) {
+	return append([]storage.Theme(nil), s.index.Load().themes...), nil
+}
+
+func (s *Store) GetTheme(ctx context.Context, id string) (*storage.Theme, error) {
+	if theme, ok := s.index.Load().themesByID[id]; ok {
+		theme := *theme
+		return &theme, nil
+	}
+	theme, err := s.Storage.GetTheme(ctx, id)
+	if err == nil && theme.Active {
+		s.markStale()
+	}
+	return theme, err
+}
+
+func (s *Store) GetThemeByText(ctx context.Context, text string) (*storage.Theme, error) {
+	if theme, ok := s.index.Load().themesByText[text]; ok {
+		theme := *theme
+		return &theme, nil
+	}
+	theme, err := s.Storage.GetThemeByText(ctx, text)
+	if err == nil && theme.Active {
+		s.markStale()
+	}
+	return theme, err
+}
+
+func (s *Store) GetActiveJokesByTheme(ctx context.Context, theme string) ([]storage.Joke, error) {
+	idx := s.index.Load()
+	if t, ok := idx.themesByText[theme]; ok {
+		return append([]storage.Joke(nil), idx.jokes[t.ID]...), nil
+	}
+	jokes, err := s.Storage.GetActiveJokesByTheme(ctx, theme)
+	if err == nil && len(jokes) > 0 {
+		s.markStale()
+	}
+	return jokes, err
+}
+
+func (s *Store) GetActiveJokesByThemeAndModel(ctx context.Context, theme, model string) ([]storage.Joke, error) {
+	idx := s.index.Load()
+	if t, ok := idx.themesByText[theme]; ok {
+		return append([]storage.Joke(nil), idx.jokesByModel[themeModel{themeID: t.ID, model: model}]...), nil
+	}
+	jokes, err := s.Storage.GetActiveJokesByThemeAndModel(ctx, theme, model)
+	if err == nil && len(jokes) > 0 {
+		s.markStale()
+	}
+	return jokes, err
+}
+
+// Close stops the refreshes and closes the wrapped storage.
+func (s *Store) Close() error {
+	s.cancel()
+	s.done.Wait()
+	return s.Storage.Close()
+}
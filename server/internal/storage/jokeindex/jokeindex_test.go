@@ -0,0 +1,137 @@
+package jokeindex
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/SaveTheRbtz/humor/server/internal/storage"
+	"github.com/SaveTheRbtz/humor/server/internal/storage/memstore"
+)
+
+func addTheme(t *testing.T, store storage.Storage, text string) {
+	t.Helper()
+	ctx := context.Background()
+	themeID, err := store.AddTheme(ctx, storage.Theme{Text: text, Active: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, joke := range []string{"1", "2"} {
+		if _, err := store.AddJoke(ctx, storage.Joke{
+			Theme:   text,
+			ThemeID: themeID,
+			Text:    text + joke,
+			Model:   "model-" + joke,
+			Active:  true,
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// countingStore counts the random themes sampled and the model lookups
+// served by the wrapped storage.
+type countingStore struct {
+	storage.Storage
+	randomThemes int
+	modelLookups int
+}
+
+func (s *countingStore) GetActiveJokesByThemeAndModel(ctx context.Context, theme, model string) ([]storage.Joke, error) {
+	s.modelLookups++
+	return s.Storage.GetActiveJokesByThemeAndModel(ctx, theme, model)
+}
+
+func (s *countingStore) GetRandomThemes(ctx context.Context, limit int) ([]storage.Theme, error) {
+	s.randomThemes++
+	return s.Storage.GetRandomThemes(ctx, limit)
+}
+
+func newStore(t *testing.T, opts Options) (*Store, *countingStore) {
+	t.Helper()
+	mem, err := memstore.NewStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	addTheme(t, mem, "Cats")
+	counting := &countingStore{Storage: mem}
+	s, err := New(context.Background(), counting, zap.NewNop(), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { s.Close() })
+	return s, counting
+}
+
+func TestRefreshOnMiss(t *testing.T) {
+	ctx := context.Background()
+	s, counting := newStore(t, Options{Interval: time.Hour, MinRefreshInterval: time.Nanosecond})
+
+	addTheme(t, counting.Storage, "Dogs")
+	if themes, err := s.GetRandomThemes(ctx, 2); err == nil {
+		t.Fatalf("new theme is in the index before a refresh: %v", themes)
+	}
+
+	// The lookup falls through to the storage and refreshes the index.
+	jokes, err := s.GetActiveJokesByTheme(ctx, "Dogs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(jokes) != 2 {
+		t.Fatalf("got %d jokes, want 2", len(jokes))
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		if _, err := s.GetRandomThemes(ctx, 2); err == nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("index was not refreshed")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if counting.randomThemes != 0 {
+		t.Errorf("the storage sampled themes %d times", counting.randomThemes)
+	}
+}
+
+func TestStorageRandomThemes(t *testing.T) {
+	ctx := context.Background()
+	s, counting := newStore(t, Options{Interval: time.Hour, StorageRandomThemes: true})
+
+	themes, err := s.GetRandomThemes(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(themes) != 1 || themes[0].Text != "Cats" {
+		t.Errorf("GetRandomThemes = %v, want Cats", themes)
+	}
+	if counting.randomThemes != 1 {
+		t.Errorf("the storage sampled themes %d times, want 1", counting.randomThemes)
+	}
+}
+
+func TestJokesByModel(t *testing.T) {
+	ctx := context.Background()
+	s, counting := newStore(t, Options{Interval: time.Hour})
+
+	jokes, err := s.GetActiveJokesByThemeAndModel(ctx, "Cats", "model-2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(jokes) != 1 || jokes[0].Text != "Cats2" {
+		t.Errorf("GetActiveJokesByThemeAndModel = %v, want Cats2", jokes)
+	}
+	jokes, err = s.GetActiveJokesByThemeAndModel(ctx, "Cats", "model-3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(jokes) != 0 {
+		t.Errorf("unknown model matched %v", jokes)
+	}
+	if counting.modelLookups != 0 {
+		t.Errorf("the storage looked up jokes by model %d times", counting.modelLookups)
+	}
+}
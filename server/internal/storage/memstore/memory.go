@@ -0,0 +1,392 @@
+// Package memstore implements storage.Storage in process memory. It is meant
+// for local development and tests that should not depend on the Firestore
+// emulator.
+package memstore
+
+import (
+	"context"
+	secureRand "crypto/rand"
+	"fmt"
+	insecureRand "math/rand/v2"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	choicesv1 "github.com/SaveTheRbtz/humor/gen/go/proto"
+	"github.com/SaveTheRbtz/humor/server/internal/storage"
+)
+
+type Store struct {
+	mu           sync.Mutex
+	random       *insecureRand.Rand
+	themes       []storage.Theme
+	jokes        []storage.Joke
+	choices      map[string]storage.Choice
+	leaderboards []storage.Leaderboard
+	topJokes     []storage.TopJokes
+	modelWeights []storage.ModelWeights
+}
+
+var _ storage.Storage = (*Store)(nil)
+
+func NewStore() (*Store, error) {
+	var seedBytes [32]byte
+	if _, err := secureRand.Read(seedBytes[:]); err != nil {
+		return nil, fmt.Errorf("failed to seed random generator: %w", err)
+	}
+
+	return &Store{
+		random:  insecureRand.New(insecureRand.NewChaCha8(seedBytes)),
+		choices: make(map[string]storage.Choice),
+	}, nil
+}
+
+func newID() string {
+	return uuid.New().String()
+}
+
+func (s *Store) GetRandomThemes(ctx context.Context, limit int) ([]storage.Theme, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	activeThemes := make([]storage.Theme, 0, len(s.themes))
+	for _, theme := range s.themes {
+		if theme.Active {
+			activeThemes = append(activeThemes, theme)
+		}
+	}
+	if len(activeThemes) < limit {
+		return nil, fmt.Errorf("not enough documents found: %d < %d", len(activeThemes), limit)
+	}
+
+	s.random.Shuffle(len(activeThemes), func(i, j int) {
+		activeThemes[i], activeThemes[j] = activeThemes[j], activeThemes[i]
+	})
+	return activeThemes[:limit], nil
+}
+
+func (s *Store) ListActiveThemes(ctx context.Context) ([]storage.Theme, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	themes := make([]storage.Theme, 0, len(s.themes))
+	for _, theme := range s.themes {
+		if theme.Active {
+			themes = append(themes, theme)
+		}
+	}
+	return themes, nil
+}
+
+func (s *Store) GetTheme(ctx context.Context, id string) (*storage.Theme, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, theme := range s.themes {
+		if theme.ID == id {
+			return &theme, nil
+		}
+	}
+	return nil, fmt.Errorf("theme %s: %w", id, storage.ErrNotFound)
+}
+
+func (s *Store) GetThemeByText(ctx context.Context, text string) (*storage.Theme, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, theme := range s.themes {
+		if theme.Text == text {
+			return &theme, nil
+		}
+	}
+	return nil, fmt.Errorf("theme %q: %w", text, storage.ErrNotFound)
+}
+
+func (s *Store) AddTheme(ctx context.Context, theme storage.Theme) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	theme.ID = newID()
+	s.themes = append(s.themes, theme)
+	return theme.ID, nil
+}
+
+func (s *Store) GetActiveJokesByTheme(ctx context.Context, theme string) ([]storage.Joke, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	jokes := make([]storage.Joke, 0)
+	for _, joke := range s.jokes {
+		if joke.Theme == theme && joke.Active {
+			jokes = append(jokes, joke)
+		}
+	}
+	return jokes, nil
+}
+
+func (s *Store) GetActiveJokesByThemeAndModel(ctx context.Context, theme, model string) ([]storage.Joke, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	jokes := make([]storage.Joke, 0)
+	for _, joke := range s.jokes {
+		if joke.Theme == theme && joke.Model == model && joke.Active {
+			jokes = append(jokes, joke)
+		}
+	}
+	return jokes, nil
+}
+
+func (s *Store) GetJoke(ctx context.Context, id string) (*storage.Joke, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, joke := range s.jokes {
+		if joke.ID == id {
+			return &joke, nil
+		}
+	}
+	return nil, fmt.Errorf("joke %s: %w", id, storage.ErrNotFound)
+}
+
+func (s *Store) ListActiveJokes(ctx context.Context) ([]storage.Joke, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	jokes := make([]storage.Joke, 0, len(s.jokes))
+	for _, joke := range s.jokes {
+		if joke.Active {
+			jokes = append(jokes, joke)
+		}
+	}
+	return jokes, nil
+}
+
+func (s *Store) AddJoke(ctx context.Context, joke storage.Joke) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	joke.ID = newID()
+	s.jokes = append(s.jokes, joke)
+	return joke.ID, nil
+}
+
+func (s *Store) SaveChoice(ctx context.Context, id string, choice storage.Choice) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	choice.ID = id
+	s.choices[id] = choice
+	return nil
+}
+
+func (s *Store) GetChoice(ctx context.Context, id string) (*storage.Choice, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	choice, ok := s.choices[id]
+	if !ok {
+		return nil, fmt.Errorf("choice %s: %w", id, storage.ErrNotFound)
+	}
+	return &choice, nil
+}
+
+func (s *Store) RateChoice(ctx context.Context, id string, vote storage.Vote, unrated *storage.Choice) (*storage.Choice, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	choice, ok := s.choices[id]
+	if !ok {
+		if unrated == nil {
+			return nil, fmt.Errorf("choice %s: %w", id, storage.ErrNotFound)
+		}
+		choice = *unrated
+		choice.ID = id
+	}
+	write, err := storage.CheckVote(&choice, vote)
+	if err != nil {
+		return nil, err
+	}
+	if write {
+		vote.Apply(&choice)
+		s.choices[id] = choice
+	}
+	return &choice, nil
+}
+
+func (s *Store) ListRatedChoices(ctx context.Context) ([]storage.Choice, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	choices := make([]storage.Choice, 0, len(s.choices))
+	for _, choice := range s.choices {
+		if storage.IsRated(choice.Winner) {
+			choices = append(choices, choice)
+		}
+	}
+	sort.Slice(choices, func(i, j int) bool {
+		return choices[i].CreatedAt.Before(choices[j].CreatedAt)
+	})
+	return choices, nil
+}
+
+func (s *Store) DeleteExpiredChoices(ctx context.Context, createdBefore time.Time) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	deleted := 0
+	for id, choice := range s.choices {
+		if choice.Winner != nil && *choice.Winner != choicesv1.Winner_UNSPECIFIED {
+			continue
+		}
+		if choice.CreatedAt.Before(createdBefore) {
+			delete(s.choices, id)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+func (s *Store) GetLatestLeaderboard(ctx context.Context) (*storage.Leaderboard, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.leaderboards) == 0 {
+		return nil, fmt.Errorf("no leaderboard documents: %w", storage.ErrNotFound)
+	}
+	latest := s.leaderboards[0]
+	for _, leaderboard := range s.leaderboards[1:] {
+		if !leaderboard.CreatedAt.Before(latest.CreatedAt) {
+			latest = leaderboard
+		}
+	}
+	return &latest, nil
+}
+
+func (s *Store) GetLeaderboard(ctx context.Context, id string) (*storage.Leaderboard, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, leaderboard := range s.leaderboards {
+		if leaderboard.ID == id {
+			return &leaderboard, nil
+		}
+	}
+	return nil, fmt.Errorf("leaderboard %s: %w", id, storage.ErrNotFound)
+}
+
+func (s *Store) ListLeaderboards(ctx context.Context, opts storage.ListLeaderboardsOptions) ([]storage.Leaderboard, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	leaderboards := make([]storage.Leaderboard, 0, len(s.leaderboards))
+	for _, leaderboard := range s.leaderboards {
+		if !opts.Start.IsZero() && leaderboard.CreatedAt.Before(opts.Start) {
+			continue
+		}
+		if !opts.End.IsZero() && !leaderboard.CreatedAt.Before(opts.End) {
+			continue
+		}
+		if after := opts.After; after != nil && (leaderboard.CreatedAt.After(after.CreatedAt) ||
+			leaderboard.CreatedAt.Equal(after.CreatedAt) && leaderboard.ID >= after.ID) {
+			continue
+		}
+		leaderboards = append(leaderboards, leaderboard)
+	}
+	sort.Slice(leaderboards, func(i, j int) bool {
+		if !leaderboards[i].CreatedAt.Equal(leaderboards[j].CreatedAt) {
+			return leaderboards[i].CreatedAt.After(leaderboards[j].CreatedAt)
+		}
+		return leaderboards[i].ID > leaderboards[j].ID
+	})
+	if opts.Limit > 0 && len(leaderboards) > opts.Limit {
+		leaderboards = leaderboards[:opts.Limit]
+	}
+	return leaderboards, nil
+}
+
+func (s *Store) AddLeaderboard(ctx context.Context, leaderboard storage.Leaderboard) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	leaderboard.ID = newID()
+	if leaderboard.CreatedAt.IsZero() {
+		leaderboard.CreatedAt = time.Now()
+	}
+	s.leaderboards = append(s.leaderboards, leaderboard)
+	return leaderboard.ID, nil
+}
+
+func (s *Store) GetLatestTopJokes(ctx context.Context) (*storage.TopJokes, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.topJokes) == 0 {
+		return nil, fmt.Errorf("no top_jokes documents: %w", storage.ErrNotFound)
+	}
+	latest := s.topJokes[0]
+	for _, topJokes := range s.topJokes[1:] {
+		if !topJokes.CreatedAt.Before(latest.CreatedAt) {
+			latest = topJokes
+		}
+	}
+	return &latest, nil
+}
+
+func (s *Store) GetTopJokes(ctx context.Context, id string) (*storage.TopJokes, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, topJokes := range s.topJokes {
+		if topJokes.ID == id {
+			return &topJokes, nil
+		}
+	}
+	return nil, fmt.Errorf("top jokes %s: %w", id, storage.ErrNotFound)
+}
+
+func (s *Store) AddTopJokes(ctx context.Context, topJokes storage.TopJokes) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	topJokes.ID = newID()
+	if topJokes.CreatedAt.IsZero() {
+		topJokes.CreatedAt = time.Now()
+	}
+	s.topJokes = append(s.topJokes, topJokes)
+	return topJokes.ID, nil
+}
+
+func (s *Store) GetLatestModelWeights(ctx context.Context) (*storage.ModelWeights, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.modelWeights) == 0 {
+		return nil, fmt.Errorf("no model_weights documents: %w", storage.ErrNotFound)
+	}
+	latest := s.modelWeights[0]
+	for _, weights := range s.modelWeights[1:] {
+		if !weights.CreatedAt.Before(latest.CreatedAt) {
+			latest = weights
+		}
+	}
+	return &latest, nil
+}
+
+func (s *Store) AddModelWeights(ctx context.Context, weights storage.ModelWeights) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	weights.ID = newID()
+	if weights.CreatedAt.IsZero() {
+		weights.CreatedAt = time.Now()
+	}
+	s.modelWeights = append(s.modelWeights, weights)
+	return weights.ID, nil
+}
+
+func (s *Store) Close() error {
+	return nil
+}
@@ -0,0 +1,18 @@
+package memstore
+
+import (
+	"testing"
+
+	"github.com/SaveTheRbtz/humor/server/internal/storage"
+	"github.com/SaveTheRbtz/humor/server/internal/storage/storagetest"
+)
+
+func TestStorage(t *testing.T) {
+	storagetest.Run(t, func(t *testing.T) storage.Storage {
+		store, err := NewStore()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return store
+	})
+}
@@ -0,0 +1,68 @@
+CREATE TABLE themes (
+    id     TEXT PRIMARY KEY,
+    text   TEXT NOT NULL,
+    random REAL NOT NULL DEFAULT 0,
+    active INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE INDEX themes_active ON themes (active);
+
+CREATE TABLE jokes (
+    id       TEXT PRIMARY KEY,
+    theme    TEXT NOT NULL,
+    theme_id TEXT NOT NULL,
+    text     TEXT NOT NULL,
+    random   REAL NOT NULL DEFAULT 0,
+    model    TEXT NOT NULL DEFAULT '',
+    policy   TEXT NOT NULL DEFAULT '',
+    active   INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE INDEX jokes_theme ON jokes (theme, active);
+
+CREATE TABLE choices (
+    id            TEXT PRIMARY KEY,
+    theme_id      TEXT NOT NULL,
+    session_id    TEXT NOT NULL,
+    left_joke_id  TEXT NOT NULL,
+    right_joke_id TEXT NOT NULL,
+    winner        INTEGER,
+    known         INTEGER,
+    created_at    INTEGER NOT NULL,
+    rated_at      INTEGER
+);
+
+CREATE INDEX choices_winner ON choices (winner, created_at);
+
+CREATE TABLE leaderboard (
+    id         TEXT PRIMARY KEY,
+    created_at INTEGER NOT NULL
+);
+
+CREATE INDEX leaderboard_created_at ON leaderboard (created_at);
+
+CREATE TABLE leaderboard_entries (
+    leaderboard_id  TEXT NOT NULL REFERENCES leaderboard (id) ON DELETE CASCADE,
+    position        INTEGER NOT NULL,
+    model           TEXT NOT NULL,
+    votes           INTEGER NOT NULL,
+    votes_good      INTEGER NOT NULL,
+    votes_bad       INTEGER NOT NULL,
+    newman_score    REAL NOT NULL,
+    newman_ci_lower REAL NOT NULL,
+    newman_ci_upper REAL NOT NULL,
+    elo_score       REAL NOT NULL,
+    elo_ci_lower    REAL NOT NULL,
+    elo_ci_upper    REAL NOT NULL,
+    PRIMARY KEY (leaderboard_id, position)
+);
+
+CREATE TABLE model_weights (
+    id            TEXT PRIMARY KEY,
+    model_weights TEXT NOT NULL,
+    shape         TEXT NOT NULL,
+    models        TEXT NOT NULL,
+    created_at    INTEGER NOT NULL
+);
+
+CREATE INDEX model_weights_created_at ON model_weights (created_at);
@@ -0,0 +1,3 @@
+ALTER TABLE jokes ADD COLUMN theme_set TEXT NOT NULL DEFAULT '';
+
+CREATE INDEX themes_text ON themes (text);
@@ -0,0 +1,16 @@
+CREATE TABLE top_jokes (
+    id         TEXT PRIMARY KEY,
+    created_at INTEGER NOT NULL
+);
+
+CREATE INDEX top_jokes_created_at ON top_jokes (created_at);
+
+CREATE TABLE top_jokes_entries (
+    top_jokes_id TEXT NOT NULL REFERENCES top_jokes (id) ON DELETE CASCADE,
+    position     INTEGER NOT NULL,
+    joke_id      TEXT NOT NULL,
+    text         TEXT NOT NULL,
+    score        REAL NOT NULL,
+    votes        INTEGER NOT NULL,
+    PRIMARY KEY (top_jokes_id, position)
+);
@@ -0,0 +1,6 @@
+ALTER TABLE top_jokes_entries ADD COLUMN theme_id TEXT NOT NULL DEFAULT '';
+ALTER TABLE top_jokes_entries ADD COLUMN theme TEXT NOT NULL DEFAULT '';
+ALTER TABLE top_jokes_entries ADD COLUMN model TEXT NOT NULL DEFAULT '';
+ALTER TABLE top_jokes_entries ADD COLUMN policy TEXT NOT NULL DEFAULT '';
+ALTER TABLE top_jokes_entries ADD COLUMN score_ci_lower REAL NOT NULL DEFAULT 0;
+ALTER TABLE top_jokes_entries ADD COLUMN score_ci_upper REAL NOT NULL DEFAULT 0;
@@ -0,0 +1 @@
+ALTER TABLE choices ADD COLUMN sampler TEXT NOT NULL DEFAULT '';
@@ -0,0 +1 @@
+ALTER TABLE choices ADD COLUMN swapped INTEGER;
@@ -0,0 +1,7 @@
+ALTER TABLE choices ADD COLUMN theme TEXT NOT NULL DEFAULT '';
+ALTER TABLE choices ADD COLUMN left_model TEXT NOT NULL DEFAULT '';
+ALTER TABLE choices ADD COLUMN left_policy TEXT NOT NULL DEFAULT '';
+ALTER TABLE choices ADD COLUMN left_theme_set TEXT NOT NULL DEFAULT '';
+ALTER TABLE choices ADD COLUMN right_model TEXT NOT NULL DEFAULT '';
+ALTER TABLE choices ADD COLUMN right_policy TEXT NOT NULL DEFAULT '';
+ALTER TABLE choices ADD COLUMN right_theme_set TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,736 @@
+// Package sqlitestore implements storage.Storage on top of an embedded SQLite
+// database, so that the arena can be self-hosted without GCP.
+package sqlitestore
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	// pure-Go SQLite driver
+	_ "modernc.org/sqlite"
+
+	choicesv1 "github.com/SaveTheRbtz/humor/gen/go/proto"
+	"github.com/SaveTheRbtz/humor/server/internal/storage"
+)
+
+type Store struct {
+	db *sql.DB
+}
+
+var _ storage.Storage = (*Store)(nil)
+
+// NewStore opens (or creates) the database at path and migrates it to the
+// latest schema version.
+func NewStore(ctx context.Context, path string) (*Store, error) {
+	dsn := fmt.Sprintf("file:%s?_pragma=foreign_keys(1)&_pragma=journal_mode(WAL)&_pragma=busy_timeout(5000)", path)
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open sqlite database: %w", err)
+	}
+	// SQLite allows a single writer; serialize access instead of failing on
+	// SQLITE_BUSY under load.
+	db.SetMaxOpenConns(1)
+
+	if err := migrate(ctx, db); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to migrate sqlite database: %w", err)
+	}
+
+	return &Store{db: db}, nil
+}
+
+func newID() string {
+	return uuid.New().String()
+}
+
+func toUnix(t time.Time) int64 {
+	return t.UnixNano()
+}
+
+func fromUnix(ns int64) time.Time {
+	return time.Unix(0, ns).UTC()
+}
+
+func (s *Store) GetRandomThemes(ctx context.Context, limit int) ([]storage.Theme, error) {
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT id, text, random, active FROM themes WHERE active = 1 ORDER BY random() LIMIT ?`,
+		limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get themes: %w", err)
+	}
+	defer rows.Close()
+
+	themes := make([]storage.Theme, 0, limit)
+	for rows.Next() {
+		var theme storage.Theme
+		if err := rows.Scan(&theme.ID, &theme.Text, &theme.Random, &theme.Active); err != nil {
+			return nil, fmt.Errorf("failed to scan theme: %w", err)
+		}
+		themes = append(themes, theme)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get themes: %w", err)
+	}
+	if len(themes) < limit {
+		return nil, fmt.Errorf("not enough documents found: %d < %d", len(themes), limit)
+	}
+	return themes, nil
+}
+
+func (s *Store) ListActiveThemes(ctx context.Context) ([]storage.Theme, error) {
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT id, text, random, active FROM themes WHERE active = 1 ORDER BY id`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get themes: %w", err)
+	}
+	defer rows.Close()
+
+	themes := make([]storage.Theme, 0)
+	for rows.Next() {
+		var theme storage.Theme
+		if err := rows.Scan(&theme.ID, &theme.Text, &theme.Random, &theme.Active); err != nil {
+			return nil, fmt.Errorf("failed to scan theme: %w", err)
+		}
+		themes = append(themes, theme)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get themes: %w", err)
+	}
+	return themes, nil
+}
+
+func (s *Store) getTheme(ctx context.Context, column, value string) (*storage.Theme, error) {
+	var theme storage.Theme
+	err := s.db.QueryRowContext(ctx,
+		`SELECT id, text, random, active FROM themes WHERE `+column+` = ? LIMIT 1`,
+		value,
+	).Scan(&theme.ID, &theme.Text, &theme.Random, &theme.Active)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("theme %q: %w", value, storage.ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get theme: %w", err)
+	}
+	return &theme, nil
+}
+
+func (s *Store) GetTheme(ctx context.Context, id string) (*storage.Theme, error) {
+	return s.getTheme(ctx, "id", id)
+}
+
+func (s *Store) GetThemeByText(ctx context.Context, text string) (*storage.Theme, error) {
+	return s.getTheme(ctx, "text", text)
+}
+
+func (s *Store) AddTheme(ctx context.Context, theme storage.Theme) (string, error) {
+	theme.ID = newID()
+	_, err := s.db.ExecContext(ctx,
+		`INSERT INTO themes (id, text, random, active) VALUES (?, ?, ?, ?)`,
+		theme.ID, theme.Text, theme.Random, theme.Active,
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to add theme: %w", err)
+	}
+	return theme.ID, nil
+}
+
+const jokeColumns = `id, theme, theme_id, text, random, model, policy, theme_set, active`
+
+func (s *Store) queryJokes(ctx context.Context, query string, args ...any) ([]storage.Joke, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get jokes: %w", err)
+	}
+	defer rows.Close()
+
+	jokes := make([]storage.Joke, 0)
+	for rows.Next() {
+		var joke storage.Joke
+		err := rows.Scan(
+			&joke.ID, &joke.Theme, &joke.ThemeID, &joke.Text,
+			&joke.Random, &joke.Model, &joke.Policy, &joke.ThemeSet, &joke.Active,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan joke: %w", err)
+		}
+		jokes = append(jokes, joke)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get jokes: %w", err)
+	}
+	return jokes, nil
+}
+
+func (s *Store) GetActiveJokesByTheme(ctx context.Context, theme string) ([]storage.Joke, error) {
+	return s.queryJokes(ctx, `SELECT `+jokeColumns+` FROM jokes WHERE theme = ? AND active = 1`, theme)
+}
+
+func (s *Store) GetActiveJokesByThemeAndModel(ctx context.Context, theme, model string) ([]storage.Joke, error) {
+	return s.queryJokes(ctx,
+		`SELECT `+jokeColumns+` FROM jokes WHERE theme = ? AND model = ? AND active = 1`, theme, model)
+}
+
+func (s *Store) GetJoke(ctx context.Context, id string) (*storage.Joke, error) {
+	jokes, err := s.queryJokes(ctx, `SELECT `+jokeColumns+` FROM jokes WHERE id = ?`, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(jokes) == 0 {
+		return nil, fmt.Errorf("joke %s: %w", id, storage.ErrNotFound)
+	}
+	return &jokes[0], nil
+}
+
+func (s *Store) ListActiveJokes(ctx context.Context) ([]storage.Joke, error) {
+	return s.queryJokes(ctx, `SELECT `+jokeColumns+` FROM jokes WHERE active = 1`)
+}
+
+func (s *Store) AddJoke(ctx context.Context, joke storage.Joke) (string, error) {
+	joke.ID = newID()
+	_, err := s.db.ExecContext(ctx,
+		`INSERT INTO jokes (`+jokeColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		joke.ID, joke.Theme, joke.ThemeID, joke.Text,
+		joke.Random, joke.Model, joke.Policy, joke.ThemeSet, joke.Active,
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to add joke: %w", err)
+	}
+	return joke.ID, nil
+}
+
+func nullWinner(w *choicesv1.Winner) sql.NullInt32 {
+	if w == nil {
+		return sql.NullInt32{}
+	}
+	return sql.NullInt32{Int32: int32(*w), Valid: true}
+}
+
+func nullTime(t *time.Time) sql.NullInt64 {
+	if t == nil {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: toUnix(*t), Valid: true}
+}
+
+func nullBool(b *bool) sql.NullBool {
+	if b == nil {
+		return sql.NullBool{}
+	}
+	return sql.NullBool{Bool: *b, Valid: true}
+}
+
+func (s *Store) SaveChoice(ctx context.Context, id string, choice storage.Choice) error {
+	_, err := s.db.ExecContext(ctx,
+		`INSERT OR REPLACE INTO choices (`+choiceColumns+`) VALUES (`+choicePlaceholders+`)`,
+		choiceValues(id, &choice)...,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save choice: %w", err)
+	}
+	return nil
+}
+
+const choiceColumns = `id, theme_id, session_id, left_joke_id, right_joke_id, winner, known, created_at, rated_at, sampler, swapped,
+	theme, left_model, left_policy, left_theme_set,
+	right_model, right_policy, right_theme_set`
+
+const choicePlaceholders = `?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?`
+
+// choiceValues returns the values of choiceColumns.
+func choiceValues(id string, choice *storage.Choice) []any {
+	var left, right storage.ChoiceJoke
+	if choice.LeftJoke != nil {
+		left = *choice.LeftJoke
+	}
+	if choice.RightJoke != nil {
+		right = *choice.RightJoke
+	}
+	return []any{
+		id, choice.ThemeID, choice.SessionID, choice.LeftJokeID, choice.RightJokeID,
+		nullWinner(choice.Winner), nullWinner(choice.Known),
+		toUnix(choice.CreatedAt), nullTime(choice.RatedAt), choice.Sampler, nullBool(choice.Swapped),
+		choice.Theme, left.Model, left.Policy, left.ThemeSet,
+		right.Model, right.Policy, right.ThemeSet,
+	}
+}
+
+func (s *Store) GetChoice(ctx context.Context, id string) (*storage.Choice, error) {
+	choice, err := scanChoice(s.db.QueryRowContext(ctx, `SELECT `+choiceColumns+` FROM choices WHERE id = ?`, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("choice %s: %w", id, storage.ErrNotFound)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &choice, nil
+}
+
+func (s *Store) RateChoice(ctx context.Context, id string, vote storage.Vote, unrated *storage.Choice) (*storage.Choice, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	choice, err := scanChoice(tx.QueryRowContext(ctx, `SELECT `+choiceColumns+` FROM choices WHERE id = ?`, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		if unrated == nil {
+			return nil, fmt.Errorf("choice %s: %w", id, storage.ErrNotFound)
+		}
+		choice = *unrated
+		choice.ID = id
+	} else if err != nil {
+		return nil, err
+	}
+
+	write, err := storage.CheckVote(&choice, vote)
+	if err != nil {
+		return nil, err
+	}
+	if !write {
+		return &choice, nil
+	}
+	vote.Apply(&choice)
+	_, err = tx.ExecContext(ctx,
+		`INSERT OR REPLACE INTO choices (`+choiceColumns+`) VALUES (`+choicePlaceholders+`)`,
+		choiceValues(id, &choice)...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update choice: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit choice: %w", err)
+	}
+	return &choice, nil
+}
+
+func (s *Store) ListRatedChoices(ctx context.Context) ([]storage.Choice, error) {
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT `+choiceColumns+` FROM choices WHERE winner IN (?, ?, ?, ?) ORDER BY created_at`,
+		int32(choicesv1.Winner_NONE), int32(choicesv1.Winner_LEFT),
+		int32(choicesv1.Winner_RIGHT), int32(choicesv1.Winner_BOTH),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get choices: %w", err)
+	}
+	defer rows.Close()
+
+	choices := make([]storage.Choice, 0)
+	for rows.Next() {
+		choice, err := scanChoice(rows)
+		if err != nil {
+			return nil, err
+		}
+		choices = append(choices, choice)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get choices: %w", err)
+	}
+	return choices, nil
+}
+
+func scanChoice(row interface{ Scan(...any) error }) (storage.Choice, error) {
+	var choice storage.Choice
+	var winner, known sql.NullInt32
+	var createdAt int64
+	var ratedAt sql.NullInt64
+	var swapped sql.NullBool
+	var left, right storage.ChoiceJoke
+	err := row.Scan(
+		&choice.ID, &choice.ThemeID, &choice.SessionID, &choice.LeftJokeID, &choice.RightJokeID,
+		&winner, &known, &createdAt, &ratedAt, &choice.Sampler, &swapped,
+		&choice.Theme, &left.Model, &left.Policy, &left.ThemeSet,
+		&right.Model, &right.Policy, &right.ThemeSet,
+	)
+	if err != nil {
+		return storage.Choice{}, fmt.Errorf("failed to scan choice: %w", err)
+	}
+	if winner.Valid {
+		w := choicesv1.Winner(winner.Int32)
+		choice.Winner = &w
+	}
+	if known.Valid {
+		k := choicesv1.Winner(known.Int32)
+		choice.Known = &k
+	}
+	choice.CreatedAt = fromUnix(createdAt)
+	if ratedAt.Valid {
+		t := fromUnix(ratedAt.Int64)
+		choice.RatedAt = &t
+	}
+	if swapped.Valid {
+		choice.Swapped = &swapped.Bool
+	}
+	if left.Model != "" {
+		choice.LeftJoke = &left
+	}
+	if right.Model != "" {
+		choice.RightJoke = &right
+	}
+	return choice, nil
+}
+
+func (s *Store) DeleteExpiredChoices(ctx context.Context, createdBefore time.Time) (int, error) {
+	result, err := s.db.ExecContext(ctx,
+		`DELETE FROM choices WHERE (winner IS NULL OR winner = ?) AND created_at < ?`,
+		int32(choicesv1.Winner_UNSPECIFIED), toUnix(createdBefore),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired choices: %w", err)
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired choices: %w", err)
+	}
+	return int(deleted), nil
+}
+
+func (s *Store) GetLatestLeaderboard(ctx context.Context) (*storage.Leaderboard, error) {
+	leaderboards, err := s.ListLeaderboards(ctx, storage.ListLeaderboardsOptions{Limit: 1})
+	if err != nil {
+		return nil, err
+	}
+	if len(leaderboards) == 0 {
+		return nil, fmt.Errorf("no leaderboard documents: %w", storage.ErrNotFound)
+	}
+	return &leaderboards[0], nil
+}
+
+func (s *Store) GetLeaderboard(ctx context.Context, id string) (*storage.Leaderboard, error) {
+	leaderboard := storage.Leaderboard{ID: id}
+	var createdAt int64
+	err := s.db.QueryRowContext(ctx,
+		`SELECT created_at FROM leaderboard WHERE id = ?`, id,
+	).Scan(&createdAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("leaderboard %s: %w", id, storage.ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get leaderboard: %w", err)
+	}
+	leaderboard.CreatedAt = fromUnix(createdAt)
+
+	if err := s.loadLeaderboardEntries(ctx, &leaderboard); err != nil {
+		return nil, err
+	}
+	return &leaderboard, nil
+}
+
+func (s *Store) ListLeaderboards(ctx context.Context, opts storage.ListLeaderboardsOptions) ([]storage.Leaderboard, error) {
+	selected := `SELECT id, created_at FROM leaderboard WHERE 1 = 1`
+	var args []any
+	if !opts.Start.IsZero() {
+		selected += ` AND created_at >= ?`
+		args = append(args, toUnix(opts.Start))
+	}
+	if !opts.End.IsZero() {
+		selected += ` AND created_at < ?`
+		args = append(args, toUnix(opts.End))
+	}
+	if opts.After != nil {
+		selected += ` AND (created_at < ? OR created_at = ? AND id < ?)`
+		createdAt := toUnix(opts.After.CreatedAt)
+		args = append(args, createdAt, createdAt, opts.After.ID)
+	}
+	selected += ` ORDER BY created_at DESC, id DESC`
+	if opts.Limit > 0 {
+		selected += ` LIMIT ?`
+		args = append(args, opts.Limit)
+	}
+
+	rows, err := s.db.QueryContext(ctx, selected, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list leaderboards: %w", err)
+	}
+	leaderboards := make([]storage.Leaderboard, 0)
+	for rows.Next() {
+		var leaderboard storage.Leaderboard
+		var createdAt int64
+		if err := rows.Scan(&leaderboard.ID, &createdAt); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan leaderboard: %w", err)
+		}
+		leaderboard.CreatedAt = fromUnix(createdAt)
+		leaderboards = append(leaderboards, leaderboard)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list leaderboards: %w", err)
+	}
+	if len(leaderboards) == 0 {
+		return leaderboards, nil
+	}
+
+	// The entries of all the listed snapshots are loaded with one query,
+	// after the cursor is closed: the pool holds a single connection.
+	byID := make(map[string]*storage.Leaderboard, len(leaderboards))
+	for i := range leaderboards {
+		byID[leaderboards[i].ID] = &leaderboards[i]
+	}
+	rows, err = s.db.QueryContext(ctx,
+		`SELECT e.leaderboard_id, e.model, e.votes, e.votes_good, e.votes_bad,
+			e.newman_score, e.newman_ci_lower, e.newman_ci_upper,
+			e.elo_score, e.elo_ci_lower, e.elo_ci_upper
+		FROM leaderboard_entries e JOIN (`+selected+`) l ON l.id = e.leaderboard_id
+		ORDER BY e.leaderboard_id, e.position`,
+		args...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get leaderboard entries: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id string
+		var entry storage.LeaderboardEntry
+		err := rows.Scan(
+			&id, &entry.Model, &entry.Votes, &entry.VotesGood, &entry.VotesBad,
+			&entry.NewmanScore, &entry.NewmanCiLower, &entry.NewmanCiUpper,
+			&entry.EloScore, &entry.EloCiLower, &entry.EloCiUpper,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan leaderboard entry: %w", err)
+		}
+		if leaderboard, ok := byID[id]; ok {
+			leaderboard.Entries = append(leaderboard.Entries, entry)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get leaderboard entries: %w", err)
+	}
+	return leaderboards, nil
+}
+
+func (s *Store) loadLeaderboardEntries(ctx context.Context, leaderboard *storage.Leaderboard) error {
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT model, votes, votes_good, votes_bad,
+			newman_score, newman_ci_lower, newman_ci_upper,
+			elo_score, elo_ci_lower, elo_ci_upper
+		FROM leaderboard_entries WHERE leaderboard_id = ? ORDER BY position`,
+		leaderboard.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to get leaderboard entries: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var entry storage.LeaderboardEntry
+		err := rows.Scan(
+			&entry.Model, &entry.Votes, &entry.VotesGood, &entry.VotesBad,
+			&entry.NewmanScore, &entry.NewmanCiLower, &entry.NewmanCiUpper,
+			&entry.EloScore, &entry.EloCiLower, &entry.EloCiUpper,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to scan leaderboard entry: %w", err)
+		}
+		leaderboard.Entries = append(leaderboard.Entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to get leaderboard entries: %w", err)
+	}
+	return nil
+}
+
+func (s *Store) AddLeaderboard(ctx context.Context, leaderboard storage.Leaderboard) (string, error) {
+	leaderboard.ID = newID()
+	if leaderboard.CreatedAt.IsZero() {
+		leaderboard.CreatedAt = time.Now()
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO leaderboard (id, created_at) VALUES (?, ?)`,
+		leaderboard.ID, toUnix(leaderboard.CreatedAt),
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to add leaderboard: %w", err)
+	}
+	for i, entry := range leaderboard.Entries {
+		_, err := tx.ExecContext(ctx,
+			`INSERT INTO leaderboard_entries
+			(leaderboard_id, position, model, votes, votes_good, votes_bad,
+			newman_score, newman_ci_lower, newman_ci_upper,
+			elo_score, elo_ci_lower, elo_ci_upper)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			leaderboard.ID, i, entry.Model, entry.Votes, entry.VotesGood, entry.VotesBad,
+			entry.NewmanScore, entry.NewmanCiLower, entry.NewmanCiUpper,
+			entry.EloScore, entry.EloCiLower, entry.EloCiUpper,
+		)
+		if err != nil {
+			return "", fmt.Errorf("failed to add leaderboard entry: %w", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return "", fmt.Errorf("failed to commit leaderboard: %w", err)
+	}
+	return leaderboard.ID, nil
+}
+
+func (s *Store) GetLatestTopJokes(ctx context.Context) (*storage.TopJokes, error) {
+	var id string
+	err := s.db.QueryRowContext(ctx,
+		`SELECT id FROM top_jokes ORDER BY created_at DESC LIMIT 1`,
+	).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("no top_jokes documents: %w", storage.ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get top jokes: %w", err)
+	}
+	return s.GetTopJokes(ctx, id)
+}
+
+func (s *Store) GetTopJokes(ctx context.Context, id string) (*storage.TopJokes, error) {
+	topJokes := storage.TopJokes{ID: id}
+	var createdAt int64
+	err := s.db.QueryRowContext(ctx,
+		`SELECT created_at FROM top_jokes WHERE id = ?`, id,
+	).Scan(&createdAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("top jokes %s: %w", id, storage.ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get top jokes: %w", err)
+	}
+	topJokes.CreatedAt = fromUnix(createdAt)
+
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT joke_id, text, theme_id, theme, model, policy,
+			score, score_ci_lower, score_ci_upper, votes
+		FROM top_jokes_entries WHERE top_jokes_id = ? ORDER BY position`,
+		topJokes.ID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get top jokes entries: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var entry storage.TopJoke
+		err := rows.Scan(
+			&entry.JokeID, &entry.Text, &entry.ThemeID, &entry.Theme, &entry.Model, &entry.Policy,
+			&entry.Score, &entry.ScoreCiLower, &entry.ScoreCiUpper, &entry.Votes,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan top jokes entry: %w", err)
+		}
+		topJokes.Entries = append(topJokes.Entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get top jokes entries: %w", err)
+	}
+	return &topJokes, nil
+}
+
+func (s *Store) AddTopJokes(ctx context.Context, topJokes storage.TopJokes) (string, error) {
+	topJokes.ID = newID()
+	if topJokes.CreatedAt.IsZero() {
+		topJokes.CreatedAt = time.Now()
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO top_jokes (id, created_at) VALUES (?, ?)`,
+		topJokes.ID, toUnix(topJokes.CreatedAt),
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to add top jokes: %w", err)
+	}
+	for i, entry := range topJokes.Entries {
+		_, err := tx.ExecContext(ctx,
+			`INSERT INTO top_jokes_entries
+			(top_jokes_id, position, joke_id, text, theme_id, theme, model, policy,
+			score, score_ci_lower, score_ci_upper, votes)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			topJokes.ID, i, entry.JokeID, entry.Text, entry.ThemeID, entry.Theme, entry.Model, entry.Policy,
+			entry.Score, entry.ScoreCiLower, entry.ScoreCiUpper, entry.Votes,
+		)
+		if err != nil {
+			return "", fmt.Errorf("failed to add top jokes entry: %w", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return "", fmt.Errorf("failed to commit top jokes: %w", err)
+	}
+	return topJokes.ID, nil
+}
+
+func (s *Store) GetLatestModelWeights(ctx context.Context) (*storage.ModelWeights, error) {
+	var weights storage.ModelWeights
+	var rawWeights, rawShape, rawModels string
+	var createdAt int64
+	err := s.db.QueryRowContext(ctx,
+		`SELECT id, model_weights, shape, models, created_at
+		FROM model_weights ORDER BY created_at DESC LIMIT 1`,
+	).Scan(&weights.ID, &rawWeights, &rawShape, &rawModels, &createdAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("no model_weights documents: %w", storage.ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get model weights: %w", err)
+	}
+	weights.CreatedAt = fromUnix(createdAt)
+
+	if err := json.Unmarshal([]byte(rawWeights), &weights.ModelWeights); err != nil {
+		return nil, fmt.Errorf("failed to parse model weights: %w", err)
+	}
+	if err := json.Unmarshal([]byte(rawShape), &weights.Shape); err != nil {
+		return nil, fmt.Errorf("failed to parse model weights shape: %w", err)
+	}
+	if err := json.Unmarshal([]byte(rawModels), &weights.Models); err != nil {
+		return nil, fmt.Errorf("failed to parse model weights models: %w", err)
+	}
+	return &weights, nil
+}
+
+func (s *Store) AddModelWeights(ctx context.Context, weights storage.ModelWeights) (string, error) {
+	weights.ID = newID()
+	if weights.CreatedAt.IsZero() {
+		weights.CreatedAt = time.Now()
+	}
+
+	rawWeights, err := json.Marshal(weights.ModelWeights)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode model weights: %w", err)
+	}
+	rawShape, err := json.Marshal(weights.Shape)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode model weights shape: %w", err)
+	}
+	rawModels, err := json.Marshal(weights.Models)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode model weights models: %w", err)
+	}
+
+	_, err = s.db.ExecContext(ctx,
+		`INSERT INTO model_weights (id, model_weights, shape, models, created_at) VALUES (?, ?, ?, ?, ?)`,
+		weights.ID, string(rawWeights), string(rawShape), string(rawModels), toUnix(weights.CreatedAt),
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to add model weights: %w", err)
+	}
+	return weights.ID, nil
+}
+
+func (s *Store) Close() error {
+	return s.db.Close()
+}
@@ -0,0 +1,311 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	choicesv1 "github.com/SaveTheRbtz/humor/gen/go/proto"
+)
+
+var (
+	// ErrNotFound is returned when a requested document does not exist.
+	ErrNotFound = errors.New("not found")
+	// ErrAlreadyRated is returned when a choice already has a different vote.
+	ErrAlreadyRated = errors.New("already rated")
+	// ErrSessionMismatch is returned when a choice is rated by a session other
+	// than the one it was served to.
+	ErrSessionMismatch = errors.New("session mismatch")
+	// ErrExpired is returned when a choice is rated after its expiry window.
+	ErrExpired = errors.New("expired")
+)
+
+type Theme struct {
+	ID     string  `firestore:"-"`
+	Text   string  `firestore:"text"`
+	Random float64 `firestore:"random"`
+	Active bool    `firestore:"active"`
+}
+
+type Joke struct {
+	ID       string  `firestore:"-"`
+	Theme    string  `firestore:"theme"`
+	ThemeID  string  `firestore:"theme_id"`
+	Text     string  `firestore:"text"`
+	Random   float64 `firestore:"random"`
+	Model    string  `firestore:"model"`
+	Policy   string  `firestore:"policy"`
+	ThemeSet string  `firestore:"theme_set"`
+	Active   bool    `firestore:"active"`
+}
+
+// ChoiceJoke is a snapshot of the generation parameters of a joke taken
+// when it is served in a choice.
+type ChoiceJoke struct {
+	Model    string `firestore:"model"`
+	Policy   string `firestore:"policy"`
+	ThemeSet string `firestore:"theme_set"`
+}
+
+// NewChoiceJoke snapshots the generation parameters of the joke.
+func NewChoiceJoke(joke *Joke) *ChoiceJoke {
+	return &ChoiceJoke{
+		Model:    joke.Model,
+		Policy:   joke.Policy,
+		ThemeSet: joke.ThemeSet,
+	}
+}
+
+type Choice struct {
+	ID          string            `firestore:"-"`
+	ThemeID     string            `firestore:"theme_id"`
+	SessionID   string            `firestore:"session_id"`
+	LeftJokeID  string            `firestore:"left_joke_id"`
+	RightJokeID string            `firestore:"right_joke_id"`
+	Winner      *choicesv1.Winner `firestore:"winner,omitempty"`
+	Known       *choicesv1.Winner `firestore:"known,omitempty"`
+	CreatedAt   time.Time         `firestore:"created_at"`
+	RatedAt     *time.Time        `firestore:"rated_at,omitempty"`
+	// Sampler is the name of the sampler that picked the jokes.
+	Sampler string `firestore:"sampler,omitempty"`
+	// Swapped records the random side assignment: whether the first joke
+	// picked by the sampler was shown on the right. It is nil for choices
+	// made before sides were randomized.
+	Swapped *bool `firestore:"swapped,omitempty"`
+	// Theme, LeftJoke and RightJoke snapshot the theme text and the jokes
+	// when the choice is served, so votes outlive deactivated jokes. They
+	// are empty for choices made before snapshots were taken.
+	Theme     string      `firestore:"theme,omitempty"`
+	LeftJoke  *ChoiceJoke `firestore:"left_joke,omitempty"`
+	RightJoke *ChoiceJoke `firestore:"right_joke,omitempty"`
+}
+
+// Jokes returns the jokes of the choice. Generation parameters are taken
+// from the snapshots if present and the rest from jokesByID, so jokes
+// missing from jokesByID only carry their ID, theme and generation
+// parameters.
+func (c *Choice) Jokes(jokesByID map[string]*Joke) (left, right *Joke, ok bool) {
+	left, leftOK := c.joke(c.LeftJokeID, c.LeftJoke, jokesByID)
+	right, rightOK := c.joke(c.RightJokeID, c.RightJoke, jokesByID)
+	return left, right, leftOK && rightOK
+}
+
+func (c *Choice) joke(id string, snapshot *ChoiceJoke, jokesByID map[string]*Joke) (*Joke, bool) {
+	joke, ok := jokesByID[id]
+	if snapshot == nil || snapshot.Model == "" {
+		return joke, ok
+	}
+	snapshotted := &Joke{
+		ID:       id,
+		Theme:    c.Theme,
+		ThemeID:  c.ThemeID,
+		Model:    snapshot.Model,
+		Policy:   snapshot.Policy,
+		ThemeSet: snapshot.ThemeSet,
+	}
+	if ok {
+		snapshotted.Text = joke.Text
+		snapshotted.Random = joke.Random
+		snapshotted.Active = joke.Active
+	}
+	return snapshotted, true
+}
+
+type LeaderboardEntry struct {
+	Model         string  `firestore:"model"`
+	Votes         int64   `firestore:"votes"`
+	VotesGood     int64   `firestore:"votes_good"`
+	VotesBad      int64   `firestore:"votes_bad"`
+	NewmanScore   float64 `firestore:"newman_score"`
+	NewmanCiLower float64 `firestore:"newman_ci_lower"`
+	NewmanCiUpper float64 `firestore:"newman_ci_upper"`
+	EloScore      float64 `firestore:"elo_score"`
+	EloCiLower    float64 `firestore:"elo_ci_lower"`
+	EloCiUpper    float64 `firestore:"elo_ci_upper"`
+}
+
+type Leaderboard struct {
+	ID        string             `firestore:"-"`
+	Entries   []LeaderboardEntry `firestore:"leaderboard"`
+	CreatedAt time.Time          `firestore:"created_at,serverTimestamp"`
+}
+
+// ListLeaderboardsOptions selects the snapshots returned by ListLeaderboards.
+// Snapshots are ordered newest first, ties broken by descending ID.
+type ListLeaderboardsOptions struct {
+	// Start and End bound the creation time to [Start, End). Zero times
+	// leave the range open.
+	Start, End time.Time
+	// After, if set, continues a listing: only snapshots ordered after it
+	// are returned.
+	After *LeaderboardCursor
+	// Limit caps the number of snapshots; zero returns all of them.
+	Limit int
+}
+
+// LeaderboardCursor is the position of a snapshot in a listing.
+type LeaderboardCursor struct {
+	CreatedAt time.Time
+	ID        string
+}
+
+// TopJoke is a joke ranked by its joke-level Bradley-Terry score.
+type TopJoke struct {
+	JokeID  string `firestore:"joke_id"`
+	Text    string `firestore:"text"`
+	ThemeID string `firestore:"theme_id"`
+	Theme   string `firestore:"theme"`
+	// Model names the joke's model like LeaderboardEntry.Model does.
+	Model        string  `firestore:"model"`
+	Policy       string  `firestore:"policy"`
+	Score        float64 `firestore:"score"`
+	ScoreCiLower float64 `firestore:"score_ci_lower"`
+	ScoreCiUpper float64 `firestore:"score_ci_upper"`
+	// Votes is the number of votes the score is based on.
+	Votes int64 `firestore:"votes"`
+}
+
+// TopJokes is a snapshot of the best jokes, best first.
+type TopJokes struct {
+	ID        string    `firestore:"-"`
+	Entries   []TopJoke `firestore:"entries"`
+	CreatedAt time.Time `firestore:"created_at,serverTimestamp"`
+}
+
+// ModelWeights is a row-normalized matrix of pair sampling weights: row i
+// holds the probabilities of pairing Models[i] with every other model.
+type ModelWeights struct {
+	ID           string    `firestore:"-"`
+	ModelWeights []float64 `firestore:"model_weights"`
+	Shape        []int     `firestore:"shape"`
+	Models       []string  `firestore:"models"`
+	CreatedAt    time.Time `firestore:"created_at,serverTimestamp"`
+}
+
+// Matrix reshapes the flat ModelWeights into rows.
+func (w *ModelWeights) Matrix() ([][]float64, error) {
+	if len(w.Shape) != 2 || w.Shape[0]*w.Shape[1] != len(w.ModelWeights) {
+		return nil, fmt.Errorf("invalid model weights shape: %v, len: %d", w.Shape, len(w.ModelWeights))
+	}
+	matrix := make([][]float64, w.Shape[0])
+	for i := range matrix {
+		matrix[i] = w.ModelWeights[i*w.Shape[1] : (i+1)*w.Shape[1]]
+	}
+	return matrix, nil
+}
+
+// IsRated reports whether w is a valid user vote.
+func IsRated(w *choicesv1.Winner) bool {
+	if w == nil {
+		return false
+	}
+	switch *w {
+	case choicesv1.Winner_NONE, choicesv1.Winner_LEFT, choicesv1.Winner_RIGHT, choicesv1.Winner_BOTH:
+		return true
+	}
+	return false
+}
+
+// Vote is a user's rating of a choice.
+type Vote struct {
+	SessionID string
+	Winner    choicesv1.Winner
+	Known     choicesv1.Winner
+	RatedAt   time.Time
+	// Expiry is how long after its creation a choice can be rated; zero
+	// disables the check.
+	Expiry time.Duration
+}
+
+// CheckVote validates the vote against the current state of the choice and
+// reports whether it has to be written. Re-submitting the stored vote is a
+// no-op.
+func CheckVote(choice *Choice, vote Vote) (bool, error) {
+	if choice.SessionID != vote.SessionID {
+		return false, fmt.Errorf("choice %s: %w", choice.ID, ErrSessionMismatch)
+	}
+	if IsRated(choice.Winner) {
+		if *choice.Winner == vote.Winner && choice.Known != nil && *choice.Known == vote.Known {
+			return false, nil
+		}
+		return false, fmt.Errorf("choice %s: %w", choice.ID, ErrAlreadyRated)
+	}
+	if vote.Expiry > 0 && vote.RatedAt.Sub(choice.CreatedAt) > vote.Expiry {
+		return false, fmt.Errorf("choice %s: %w", choice.ID, ErrExpired)
+	}
+	return true, nil
+}
+
+// Apply records the vote in the choice.
+func (v Vote) Apply(choice *Choice) {
+	winner, known, ratedAt := v.Winner, v.Known, v.RatedAt
+	choice.Winner = &winner
+	choice.Known = &known
+	choice.RatedAt = &ratedAt
+}
+
+// Storage is the persistence layer used by the arena server.
+type Storage interface {
+	// GetRandomThemes returns limit distinct random active themes.
+	GetRandomThemes(ctx context.Context, limit int) ([]Theme, error)
+	// ListActiveThemes returns all active themes.
+	ListActiveThemes(ctx context.Context) ([]Theme, error)
+	// GetTheme returns the theme with the given ID.
+	GetTheme(ctx context.Context, id string) (*Theme, error)
+	// GetThemeByText returns a theme with the given text.
+	GetThemeByText(ctx context.Context, text string) (*Theme, error)
+	// AddTheme stores a new theme and returns its ID.
+	AddTheme(ctx context.Context, theme Theme) (string, error)
+
+	// GetActiveJokesByTheme returns all active jokes for the theme text.
+	GetActiveJokesByTheme(ctx context.Context, theme string) ([]Joke, error)
+	// GetActiveJokesByThemeAndModel returns the active jokes of the model
+	// for the theme text.
+	GetActiveJokesByThemeAndModel(ctx context.Context, theme, model string) ([]Joke, error)
+	// GetJoke returns the joke with the given ID.
+	GetJoke(ctx context.Context, id string) (*Joke, error)
+	// ListActiveJokes returns all active jokes.
+	ListActiveJokes(ctx context.Context) ([]Joke, error)
+	// AddJoke stores a new joke and returns its ID.
+	AddJoke(ctx context.Context, joke Joke) (string, error)
+
+	// SaveChoice stores a choice under the given ID.
+	SaveChoice(ctx context.Context, id string, choice Choice) error
+	// GetChoice returns the choice with the given ID.
+	GetChoice(ctx context.Context, id string) (*Choice, error)
+	// RateChoice atomically validates the vote with CheckVote and records it.
+	// If no choice with the given ID is stored, the vote is recorded for the
+	// unrated choice instead, or ErrNotFound is returned if it is nil. It
+	// returns the rated choice.
+	RateChoice(ctx context.Context, id string, vote Vote, unrated *Choice) (*Choice, error)
+	// ListRatedChoices returns all choices with a NONE, LEFT, RIGHT or BOTH
+	// winner.
+	ListRatedChoices(ctx context.Context) ([]Choice, error)
+	// DeleteExpiredChoices deletes unrated choices created before the
+	// deadline and returns how many were deleted.
+	DeleteExpiredChoices(ctx context.Context, createdBefore time.Time) (int, error)
+
+	// GetLatestLeaderboard returns the most recent leaderboard snapshot.
+	GetLatestLeaderboard(ctx context.Context) (*Leaderboard, error)
+	// GetLeaderboard returns the leaderboard snapshot with the given ID.
+	GetLeaderboard(ctx context.Context, id string) (*Leaderboard, error)
+	// ListLeaderboards returns the snapshots selected by opts.
+	ListLeaderboards(ctx context.Context, opts ListLeaderboardsOptions) ([]Leaderboard, error)
+	// AddLeaderboard stores a new leaderboard snapshot and returns its ID.
+	AddLeaderboard(ctx context.Context, leaderboard Leaderboard) (string, error)
+
+	// GetLatestTopJokes returns the most recent top jokes snapshot.
+	GetLatestTopJokes(ctx context.Context) (*TopJokes, error)
+	// GetTopJokes returns the top jokes snapshot with the given ID.
+	GetTopJokes(ctx context.Context, id string) (*TopJokes, error)
+	// AddTopJokes stores a new top jokes snapshot and returns its ID.
+	AddTopJokes(ctx context.Context, topJokes TopJokes) (string, error)
+
+	// GetLatestModelWeights returns the most recent model weights matrix.
+	GetLatestModelWeights(ctx context.Context) (*ModelWeights, error)
+	// AddModelWeights stores a new model weights matrix and returns its ID.
+	AddModelWeights(ctx context.Context, weights ModelWeights) (string, error)
+
+	Close() error
+}
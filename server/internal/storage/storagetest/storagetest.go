@@ -0,0 +1,328 @@
+// Package storagetest checks that a storage.Storage implementation behaves
+// like the others.
+package storagetest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	choicesv1 "github.com/SaveTheRbtz/humor/gen/go/proto"
+	"github.com/SaveTheRbtz/humor/server/internal/storage"
+)
+
+// Run runs the conformance tests against fresh stores created by newStore.
+func Run(t *testing.T, newStore func(t *testing.T) storage.Storage) {
+	t.Run("ThemesAndJokes", func(t *testing.T) { testThemesAndJokes(t, newStore(t)) })
+	t.Run("RateChoice", func(t *testing.T) { testRateChoice(t, newStore(t)) })
+	t.Run("DeleteExpiredChoices", func(t *testing.T) { testDeleteExpiredChoices(t, newStore(t)) })
+	t.Run("Snapshots", func(t *testing.T) { testSnapshots(t, newStore(t)) })
+}
+
+func testThemesAndJokes(t *testing.T, store storage.Storage) {
+	ctx := context.Background()
+
+	activeID, err := store.AddTheme(ctx, storage.Theme{Text: "Cats", Active: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.AddTheme(ctx, storage.Theme{Text: "Dogs"}); err != nil {
+		t.Fatal(err)
+	}
+
+	themes, err := store.ListActiveThemes(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(themes) != 1 || themes[0].ID != activeID {
+		t.Fatalf("ListActiveThemes = %+v, want only %s", themes, activeID)
+	}
+	random, err := store.GetRandomThemes(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(random) != 1 || random[0].ID != activeID {
+		t.Fatalf("GetRandomThemes = %+v, want only %s", random, activeID)
+	}
+	if _, err := store.GetRandomThemes(ctx, 2); err == nil {
+		t.Error("GetRandomThemes returned more themes than are active")
+	}
+	theme, err := store.GetThemeByText(ctx, "Cats")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if theme.ID != activeID {
+		t.Errorf("GetThemeByText ID = %s, want %s", theme.ID, activeID)
+	}
+	if _, err := store.GetTheme(ctx, "missing"); !errors.Is(err, storage.ErrNotFound) {
+		t.Errorf("GetTheme(missing) error = %v, want ErrNotFound", err)
+	}
+
+	jokeID, err := store.AddJoke(ctx, storage.Joke{
+		Theme: "Cats", ThemeID: activeID, Text: "meow", Model: "m", Active: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.AddJoke(ctx, storage.Joke{Theme: "Cats", ThemeID: activeID, Text: "purr"}); err != nil {
+		t.Fatal(err)
+	}
+	jokes, err := store.GetActiveJokesByTheme(ctx, "Cats")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(jokes) != 1 || jokes[0].ID != jokeID || jokes[0].Model != "m" {
+		t.Fatalf("GetActiveJokesByTheme = %+v, want only %s", jokes, jokeID)
+	}
+	if _, err := store.AddJoke(ctx, storage.Joke{
+		Theme: "Cats", ThemeID: activeID, Text: "hiss", Model: "n", Active: true,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	jokes, err = store.GetActiveJokesByThemeAndModel(ctx, "Cats", "m")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(jokes) != 1 || jokes[0].ID != jokeID {
+		t.Fatalf("GetActiveJokesByThemeAndModel = %+v, want only %s", jokes, jokeID)
+	}
+	joke, err := store.GetJoke(ctx, jokeID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if joke.Text != "meow" {
+		t.Errorf("GetJoke text = %q, want meow", joke.Text)
+	}
+	if _, err := store.GetJoke(ctx, "missing"); !errors.Is(err, storage.ErrNotFound) {
+		t.Errorf("GetJoke(missing) error = %v, want ErrNotFound", err)
+	}
+}
+
+func vote(session string, winner choicesv1.Winner) storage.Vote {
+	return storage.Vote{
+		SessionID: session,
+		Winner:    winner,
+		Known:     choicesv1.Winner_NONE,
+		RatedAt:   time.Now(),
+		Expiry:    time.Hour,
+	}
+}
+
+func testRateChoice(t *testing.T, store storage.Storage) {
+	ctx := context.Background()
+
+	noWinner := choicesv1.Winner_UNSPECIFIED
+	choice := storage.Choice{
+		ThemeID:     "theme",
+		SessionID:   "session",
+		LeftJokeID:  "left",
+		RightJokeID: "right",
+		CreatedAt:   time.Now().Add(-time.Minute),
+		Theme:       "Cats",
+		LeftJoke:    &storage.ChoiceJoke{Model: "m1"},
+		RightJoke:   &storage.ChoiceJoke{Model: "m2"},
+		Winner:      &noWinner,
+	}
+	if err := store.SaveChoice(ctx, "stored", choice); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := store.RateChoice(ctx, "stored", vote("other", choicesv1.Winner_LEFT), nil); !errors.Is(err, storage.ErrSessionMismatch) {
+		t.Errorf("rating from another session: error = %v, want ErrSessionMismatch", err)
+	}
+
+	rated, err := store.RateChoice(ctx, "stored", vote("session", choicesv1.Winner_LEFT), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rated.Winner == nil || *rated.Winner != choicesv1.Winner_LEFT || rated.RatedAt == nil {
+		t.Fatalf("RateChoice = %+v, want a LEFT vote", rated)
+	}
+	if rated.LeftJoke == nil || rated.LeftJoke.Model != "m1" || rated.Theme != "Cats" {
+		t.Errorf("RateChoice lost the snapshot: %+v", rated)
+	}
+
+	again, err := store.RateChoice(ctx, "stored", vote("session", choicesv1.Winner_LEFT), nil)
+	if err != nil {
+		t.Fatalf("identical re-submission: %v", err)
+	}
+	if !again.RatedAt.Equal(*rated.RatedAt) {
+		t.Errorf("identical re-submission changed the rating time")
+	}
+	if _, err := store.RateChoice(ctx, "stored", vote("session", choicesv1.Winner_RIGHT), nil); !errors.Is(err, storage.ErrAlreadyRated) {
+		t.Errorf("changing the vote: error = %v, want ErrAlreadyRated", err)
+	}
+
+	if _, err := store.RateChoice(ctx, "missing", vote("session", choicesv1.Winner_LEFT), nil); !errors.Is(err, storage.ErrNotFound) {
+		t.Errorf("rating a missing choice: error = %v, want ErrNotFound", err)
+	}
+
+	// Unrated choices from tokens are only stored once rated.
+	if _, err := store.RateChoice(ctx, "token", vote("session", choicesv1.Winner_BOTH), &choice); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.RateChoice(ctx, "token", vote("session", choicesv1.Winner_NONE), &choice); !errors.Is(err, storage.ErrAlreadyRated) {
+		t.Errorf("rating a token twice: error = %v, want ErrAlreadyRated", err)
+	}
+
+	expired := choice
+	expired.CreatedAt = time.Now().Add(-2 * time.Hour)
+	if _, err := store.RateChoice(ctx, "expired", vote("session", choicesv1.Winner_LEFT), &expired); !errors.Is(err, storage.ErrExpired) {
+		t.Errorf("rating an expired choice: error = %v, want ErrExpired", err)
+	}
+
+	choices, err := store.ListRatedChoices(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids := make(map[string]bool)
+	for _, c := range choices {
+		ids[c.ID] = true
+	}
+	if len(choices) != 2 || !ids["stored"] || !ids["token"] {
+		t.Errorf("ListRatedChoices = %v, want stored and token", ids)
+	}
+}
+
+func testDeleteExpiredChoices(t *testing.T, store storage.Storage) {
+	ctx := context.Background()
+	now := time.Now()
+
+	noWinner := choicesv1.Winner_UNSPECIFIED
+	for id, createdAt := range map[string]time.Time{
+		"old":   now.Add(-2 * time.Hour),
+		"new":   now,
+		"rated": now.Add(-2 * time.Hour),
+	} {
+		err := store.SaveChoice(ctx, id, storage.Choice{SessionID: "session", CreatedAt: createdAt, Winner: &noWinner})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	rating := vote("session", choicesv1.Winner_LEFT)
+	rating.Expiry = 0
+	if _, err := store.RateChoice(ctx, "rated", rating, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	deleted, err := store.DeleteExpiredChoices(ctx, now.Add(-time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deleted != 1 {
+		t.Errorf("DeleteExpiredChoices deleted %d choices, want 1", deleted)
+	}
+	if _, err := store.GetChoice(ctx, "old"); !errors.Is(err, storage.ErrNotFound) {
+		t.Errorf("expired choice was kept: %v", err)
+	}
+	for _, id := range []string{"new", "rated"} {
+		if _, err := store.GetChoice(ctx, id); err != nil {
+			t.Errorf("choice %s was deleted: %v", id, err)
+		}
+	}
+}
+
+func testSnapshots(t *testing.T, store storage.Storage) {
+	ctx := context.Background()
+
+	if _, err := store.GetLatestLeaderboard(ctx); !errors.Is(err, storage.ErrNotFound) {
+		t.Errorf("GetLatestLeaderboard on an empty store: error = %v, want ErrNotFound", err)
+	}
+	if _, err := store.GetLatestModelWeights(ctx); !errors.Is(err, storage.ErrNotFound) {
+		t.Errorf("GetLatestModelWeights on an empty store: error = %v, want ErrNotFound", err)
+	}
+	if _, err := store.GetLatestTopJokes(ctx); !errors.Is(err, storage.ErrNotFound) {
+		t.Errorf("GetLatestTopJokes on an empty store: error = %v, want ErrNotFound", err)
+	}
+
+	base := time.Now().Add(-time.Hour).Truncate(time.Second)
+	var ids []string
+	for i, model := range []string{"first", "second"} {
+		id, err := store.AddLeaderboard(ctx, storage.Leaderboard{
+			Entries:   []storage.LeaderboardEntry{{Model: model, Votes: int64(i + 1)}},
+			CreatedAt: base.Add(time.Duration(i) * time.Minute),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, id)
+	}
+	latest, err := store.GetLatestLeaderboard(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if latest.ID != ids[1] || len(latest.Entries) != 1 || latest.Entries[0].Model != "second" {
+		t.Errorf("GetLatestLeaderboard = %+v, want %s", latest, ids[1])
+	}
+	first, err := store.GetLeaderboard(ctx, ids[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.Entries[0].Model != "first" {
+		t.Errorf("GetLeaderboard(%s) = %+v", ids[0], first)
+	}
+	boards, err := store.ListLeaderboards(ctx, storage.ListLeaderboardsOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(boards) != 2 || boards[0].ID != ids[1] || boards[0].Entries[0].Model != "second" {
+		t.Errorf("ListLeaderboards = %+v, want 2 snapshots newest first", boards)
+	}
+
+	// Snapshots created at the same time are listed one page at a time.
+	for _, model := range []string{"tie-a", "tie-b"} {
+		if _, err := store.AddLeaderboard(ctx, storage.Leaderboard{
+			Entries:   []storage.LeaderboardEntry{{Model: model}},
+			CreatedAt: base.Add(-time.Minute),
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	listed := make(map[string]bool)
+	var after *storage.LeaderboardCursor
+	for range 5 {
+		page, err := store.ListLeaderboards(ctx, storage.ListLeaderboardsOptions{After: after, Limit: 1})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(page) == 0 {
+			break
+		}
+		listed[page[0].Entries[0].Model] = true
+		after = &storage.LeaderboardCursor{CreatedAt: page[0].CreatedAt, ID: page[0].ID}
+	}
+	if len(listed) != 4 {
+		t.Errorf("ListLeaderboards pages listed %v, want 4 snapshots", listed)
+	}
+
+	weightsID, err := store.AddModelWeights(ctx, storage.ModelWeights{
+		ModelWeights: []float64{0, 1, 1, 0},
+		Shape:        []int{2, 2},
+		Models:       []string{"a", "b"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	weights, err := store.GetLatestModelWeights(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if weights.ID != weightsID || len(weights.Models) != 2 {
+		t.Errorf("GetLatestModelWeights = %+v, want %s", weights, weightsID)
+	}
+
+	topJokesID, err := store.AddTopJokes(ctx, storage.TopJokes{
+		Entries: []storage.TopJoke{{JokeID: "joke", Text: "meow", Model: "m", Votes: 3}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	topJokes, err := store.GetTopJokes(ctx, topJokesID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(topJokes.Entries) != 1 || topJokes.Entries[0].JokeID != "joke" || topJokes.Entries[0].Model != "m" {
+		t.Errorf("GetTopJokes = %+v", topJokes)
+	}
+}
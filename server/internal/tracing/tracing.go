@@ -0,0 +1,120 @@
+// Package tracing sets up OpenTelemetry tracing for the arena server.
+package tracing
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// None only propagates trace context without recording spans.
+	None = "none"
+	// OTLP exports spans over OTLP/gRPC, configured with the standard
+	// OTEL_EXPORTER_OTLP_* environment variables.
+	OTLP = "otlp"
+	// Stdout prints spans to stdout for local runs.
+	Stdout = "stdout"
+)
+
+// ServiceName is the default service.name resource attribute, overridden by
+// OTEL_SERVICE_NAME.
+const ServiceName = "humor-arena"
+
+type Options struct {
+	// Exporter is one of None, OTLP or Stdout.
+	Exporter string
+	// SampleRatio is the fraction of new traces that are recorded. Traces
+	// started upstream follow the parent's decision.
+	SampleRatio float64
+}
+
+// RegisterFlags binds the options to command line flags.
+func (o *Options) RegisterFlags(fs *flag.FlagSet) {
+	fs.StringVar(&o.Exporter, "trace-exporter", None, "trace exporter: none, otlp or stdout")
+	fs.Float64Var(&o.SampleRatio, "trace-sample-ratio", 1, "fraction of new traces to record")
+}
+
+// Validate checks the exporter and the sample ratio.
+func (o *Options) Validate() error {
+	var errs []error
+	switch o.Exporter {
+	case None, OTLP, Stdout:
+	default:
+		errs = append(errs, fmt.Errorf("unknown trace exporter: %q", o.Exporter))
+	}
+	if o.SampleRatio < 0 || o.SampleRatio > 1 {
+		errs = append(errs, fmt.Errorf("trace sample ratio must be within [0, 1]: %v", o.SampleRatio))
+	}
+	return errors.Join(errs...)
+}
+
+// Setup installs the global tracer provider and propagator described by the
+// options. The returned function flushes and stops the exporter.
+func Setup(ctx context.Context, opts Options) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch opts.Exporter {
+	case None:
+		return func(context.Context) error { return nil }, nil
+	case OTLP:
+		exporter, err = otlptracegrpc.New(ctx)
+	case Stdout:
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+	default:
+		return nil, fmt.Errorf("unknown trace exporter: %q", opts.Exporter)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s trace exporter: %w", opts.Exporter, err)
+	}
+
+	res, err := resource.Merge(
+		resource.Default(),
+		resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(ServiceName)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create trace resource: %w", err)
+	}
+	// Environment variables take precedence over the default service name.
+	res, err = resource.Merge(res, resource.Environment())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create trace resource: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(opts.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// GatewayMiddleware names the HTTP server span of a gateway request after
+// its route pattern.
+func GatewayMiddleware(next runtime.HandlerFunc) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		if pattern, ok := runtime.HTTPPattern(r.Context()); ok {
+			trace.SpanFromContext(r.Context()).SetName(r.Method + " " + pattern.String())
+		}
+		next(w, r, pathParams)
+	}
+}
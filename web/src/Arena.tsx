@@ -1,5 +1,5 @@
 import React, { useEffect, useState } from 'react';
-import { ArenaApi, Configuration, V1GetChoicesResponse, V1Winner } from './apiClient';
+import { ArenaApi, Configuration, V1GetChoicesResponse, V1RateChoicesResponse, V1Winner } from './apiClient';
 import {JokeCard} from './JokeCard';
 import { getErrorMessage } from './errorUtils';
 import './Arena.css';
@@ -19,12 +19,15 @@ type Choice = {
 
 const Arena: React.FC = () => {
   const [choice, setChoice] = useState<Choice | null>(null);
+  // The models behind the jokes, shown once the choice is rated.
+  const [reveal, setReveal] = useState<V1RateChoicesResponse | null>(null);
   const [loading, setLoading] = useState<boolean>(false);
   const [error, setError] = useState<string | null>(null);
 
   const fetchChoices = async () => {
     setLoading(true);
     setError(null);
+    setReveal(null);
 
     try {
       const response: V1GetChoicesResponse = await api.arenaGetChoices(
@@ -49,15 +52,15 @@ const Arena: React.FC = () => {
     if (!choice) return;
 
     try {
-      await api.arenaRateChoices({
+      const response = await api.arenaRateChoices({
         id: choice.id,
         body: {
           winner: winner,
           known: choice.known,
+          sessionId: sessionStorage.getItem('userId') || '',
         },
       });
-      // Fetch new jokes after voting
-      fetchChoices();
+      setReveal(response);
     } catch (err) {
       setError('Failed to submit your choice.');
     }
@@ -90,17 +93,25 @@ const Arena: React.FC = () => {
       <div className="jokes-container">
         <JokeCard
           jokeText={choice.leftJoke}
+          info={reveal?.leftJoke}
         />
         <JokeCard
           jokeText={choice.rightJoke}
+          info={reveal?.rightJoke}
         />
       </div>
-      <div className="additional-options">
-        <button className="button" onClick={() => handleVote(V1Winner.Left)}>Left is better</button>
-        <button className="both-button" onClick={() => handleVote(V1Winner.Both)}>Both are great</button>
-        <button className="neither-button" onClick={() => handleVote(V1Winner.None)}>Neither are good</button>
-        <button className="button" onClick={() => handleVote(V1Winner.Right)}>Right is better</button>
-      </div>
+      {reveal ? (
+        <div className="additional-options">
+          <button className="button" onClick={fetchChoices}>Next jokes</button>
+        </div>
+      ) : (
+        <div className="additional-options">
+          <button className="button" onClick={() => handleVote(V1Winner.Left)}>Left is better</button>
+          <button className="both-button" onClick={() => handleVote(V1Winner.Both)}>Both are great</button>
+          <button className="neither-button" onClick={() => handleVote(V1Winner.None)}>Neither are good</button>
+          <button className="button" onClick={() => handleVote(V1Winner.Right)}>Right is better</button>
+        </div>
+      )}
     </div>
   );
 };
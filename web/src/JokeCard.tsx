@@ -1,12 +1,15 @@
 import React from 'react';
 import { FaTwitter } from 'react-icons/fa';
+import { V1JokeInfo } from './apiClient';
 import './JokeCard.css';
 
 type JokeCardProps = {
   jokeText: string;
+  // Revealed after the vote.
+  info?: V1JokeInfo;
 };
 
-const JokeCard: React.FC<JokeCardProps> = ({ jokeText }) => {
+const JokeCard: React.FC<JokeCardProps> = ({ jokeText, info }) => {
   const handleShare = (e: React.MouseEvent) => {
     e.stopPropagation(); // Prevent any parent handlers from being notified of the event
 
@@ -18,6 +21,13 @@ const JokeCard: React.FC<JokeCardProps> = ({ jokeText }) => {
   return (
     <div className="joke-card">
       <p>{jokeText}</p>
+      {info && (
+        <div className="joke-info">
+          <strong>{info.model}</strong>
+          {info.policy && <span> · {info.policy}</span>}
+          {info.themeSet && <span> · {info.themeSet}</span>}
+        </div>
+      )}
       <button className="share-icon" onClick={handleShare} aria-label="Share on Twitter">
         <FaTwitter size={24} color="#1DA1F2" />
       </button>
@@ -17,25 +17,116 @@ import * as runtime from '../runtime';
 import type {
   ArenaRateChoicesBody,
   RpcStatus,
+  V1DiffLeaderboardSnapshotsResponse,
   V1GetChoicesResponse,
+  V1GetHeadToHeadMatrixResponse,
+  V1GetHeadToHeadResponse,
   V1GetLeaderboardResponse,
+  V1GetModelHistoryResponse,
+  V1GetPositionBiasResponse,
   V1GetTopJokesResponse,
+  V1ListLeaderboardSnapshotsResponse,
+  V1RateChoicesResponse,
 } from '../models/index';
 import {
     ArenaRateChoicesBodyFromJSON,
     ArenaRateChoicesBodyToJSON,
     RpcStatusFromJSON,
     RpcStatusToJSON,
+    V1DiffLeaderboardSnapshotsResponseFromJSON,
+    V1DiffLeaderboardSnapshotsResponseToJSON,
     V1GetChoicesResponseFromJSON,
     V1GetChoicesResponseToJSON,
+    V1GetHeadToHeadMatrixResponseFromJSON,
+    V1GetHeadToHeadMatrixResponseToJSON,
+    V1GetHeadToHeadResponseFromJSON,
+    V1GetHeadToHeadResponseToJSON,
     V1GetLeaderboardResponseFromJSON,
     V1GetLeaderboardResponseToJSON,
+    V1GetModelHistoryResponseFromJSON,
+    V1GetModelHistoryResponseToJSON,
+    V1GetPositionBiasResponseFromJSON,
+    V1GetPositionBiasResponseToJSON,
     V1GetTopJokesResponseFromJSON,
     V1GetTopJokesResponseToJSON,
+    V1ListLeaderboardSnapshotsResponseFromJSON,
+    V1ListLeaderboardSnapshotsResponseToJSON,
+    V1RateChoicesResponseFromJSON,
+    V1RateChoicesResponseToJSON,
 } from '../models/index';
 
+export interface ArenaDiffLeaderboardSnapshotsRequest {
+    baseId: string;
+    targetId: string;
+}
+
 export interface ArenaGetChoicesRequest {
     sessionId?: string;
+    themeId?: string;
+    theme?: string;
+    themeSet?: string;
+}
+
+export interface ArenaGetHeadToHeadRequest {
+    modelA: string;
+    modelB: string;
+    themeSet?: string;
+    policy?: string;
+    startTime?: Date;
+    endTime?: Date;
+    excludeKnown?: boolean;
+    maxExamples?: number;
+}
+
+export interface ArenaGetHeadToHeadMatrixRequest {
+    themeSet?: string;
+    policy?: string;
+    startTime?: Date;
+    endTime?: Date;
+    excludeKnown?: boolean;
+    maxExamples?: number;
+}
+
+export interface ArenaGetLeaderboardRequest {
+    themeSet?: string;
+    policy?: string;
+    startTime?: Date;
+    endTime?: Date;
+    excludeKnown?: boolean;
+}
+
+export interface ArenaGetLeaderboardSnapshotRequest {
+    id: string;
+}
+
+export interface ArenaGetModelHistoryRequest {
+    model: string;
+    startTime?: Date;
+    endTime?: Date;
+}
+
+export interface ArenaGetPositionBiasRequest {
+    themeSet?: string;
+    policy?: string;
+    startTime?: Date;
+    endTime?: Date;
+    excludeKnown?: boolean;
+    randomizedOnly?: boolean;
+}
+
+export interface ArenaGetTopJokesRequest {
+    pageSize?: number;
+    pageToken?: string;
+    model?: string;
+    themeId?: string;
+    theme?: string;
+}
+
+export interface ArenaListLeaderboardSnapshotsRequest {
+    pageSize?: number;
+    pageToken?: string;
+    startTime?: Date;
+    endTime?: Date;
 }
 
 export interface ArenaRateChoicesRequest {
@@ -48,6 +139,46 @@ export interface ArenaRateChoicesRequest {
  */
 export class ArenaApi extends runtime.BaseAPI {
 
+    /**
+     * Compares two leaderboard snapshots.
+     */
+    async arenaDiffLeaderboardSnapshotsRaw(requestParameters: ArenaDiffLeaderboardSnapshotsRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<V1DiffLeaderboardSnapshotsResponse>> {
+        if (requestParameters['baseId'] == null) {
+            throw new runtime.RequiredError(
+                'baseId',
+                'Required parameter "baseId" was null or undefined when calling arenaDiffLeaderboardSnapshots().'
+            );
+        }
+
+        if (requestParameters['targetId'] == null) {
+            throw new runtime.RequiredError(
+                'targetId',
+                'Required parameter "targetId" was null or undefined when calling arenaDiffLeaderboardSnapshots().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        const response = await this.request({
+            path: `/v1/leaderboard/snapshots/{baseId}/diff/{targetId}`.replace(`{${"baseId"}}`, encodeURIComponent(String(requestParameters['baseId']))).replace(`{${"targetId"}}`, encodeURIComponent(String(requestParameters['targetId']))),
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => V1DiffLeaderboardSnapshotsResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * Compares two leaderboard snapshots.
+     */
+    async arenaDiffLeaderboardSnapshots(requestParameters: ArenaDiffLeaderboardSnapshotsRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<V1DiffLeaderboardSnapshotsResponse> {
+        const response = await this.arenaDiffLeaderboardSnapshotsRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
     /**
      * Retrieves a pair of jokes for comparison.
      */
@@ -58,6 +189,18 @@ export class ArenaApi extends runtime.BaseAPI {
             queryParameters['sessionId'] = requestParameters['sessionId'];
         }
 
+        if (requestParameters['themeId'] != null) {
+            queryParameters['themeId'] = requestParameters['themeId'];
+        }
+
+        if (requestParameters['theme'] != null) {
+            queryParameters['theme'] = requestParameters['theme'];
+        }
+
+        if (requestParameters['themeSet'] != null) {
+            queryParameters['themeSet'] = requestParameters['themeSet'];
+        }
+
         const headerParameters: runtime.HTTPHeaders = {};
 
         const response = await this.request({
@@ -78,12 +221,146 @@ export class ArenaApi extends runtime.BaseAPI {
         return await response.value();
     }
 
+    /**
+     * Gets the votes between two models.
+     */
+    async arenaGetHeadToHeadRaw(requestParameters: ArenaGetHeadToHeadRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<V1GetHeadToHeadResponse>> {
+        if (requestParameters['modelA'] == null) {
+            throw new runtime.RequiredError(
+                'modelA',
+                'Required parameter "modelA" was null or undefined when calling arenaGetHeadToHead().'
+            );
+        }
+
+        if (requestParameters['modelB'] == null) {
+            throw new runtime.RequiredError(
+                'modelB',
+                'Required parameter "modelB" was null or undefined when calling arenaGetHeadToHead().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        if (requestParameters['themeSet'] != null) {
+            queryParameters['themeSet'] = requestParameters['themeSet'];
+        }
+
+        if (requestParameters['policy'] != null) {
+            queryParameters['policy'] = requestParameters['policy'];
+        }
+
+        if (requestParameters['startTime'] != null) {
+            queryParameters['startTime'] = (requestParameters['startTime'] as any).toISOString();
+        }
+
+        if (requestParameters['endTime'] != null) {
+            queryParameters['endTime'] = (requestParameters['endTime'] as any).toISOString();
+        }
+
+        if (requestParameters['excludeKnown'] != null) {
+            queryParameters['excludeKnown'] = requestParameters['excludeKnown'];
+        }
+
+        if (requestParameters['maxExamples'] != null) {
+            queryParameters['maxExamples'] = requestParameters['maxExamples'];
+        }
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        const response = await this.request({
+            path: `/v1/leaderboard/models/{modelA}/vs/{modelB}`.replace(`{${"modelA"}}`, encodeURIComponent(String(requestParameters['modelA']))).replace(`{${"modelB"}}`, encodeURIComponent(String(requestParameters['modelB']))),
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => V1GetHeadToHeadResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * Gets the votes between two models.
+     */
+    async arenaGetHeadToHead(requestParameters: ArenaGetHeadToHeadRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<V1GetHeadToHeadResponse> {
+        const response = await this.arenaGetHeadToHeadRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Gets the votes between every pair of models.
+     */
+    async arenaGetHeadToHeadMatrixRaw(requestParameters: ArenaGetHeadToHeadMatrixRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<V1GetHeadToHeadMatrixResponse>> {
+        const queryParameters: any = {};
+
+        if (requestParameters['themeSet'] != null) {
+            queryParameters['themeSet'] = requestParameters['themeSet'];
+        }
+
+        if (requestParameters['policy'] != null) {
+            queryParameters['policy'] = requestParameters['policy'];
+        }
+
+        if (requestParameters['startTime'] != null) {
+            queryParameters['startTime'] = (requestParameters['startTime'] as any).toISOString();
+        }
+
+        if (requestParameters['endTime'] != null) {
+            queryParameters['endTime'] = (requestParameters['endTime'] as any).toISOString();
+        }
+
+        if (requestParameters['excludeKnown'] != null) {
+            queryParameters['excludeKnown'] = requestParameters['excludeKnown'];
+        }
+
+        if (requestParameters['maxExamples'] != null) {
+            queryParameters['maxExamples'] = requestParameters['maxExamples'];
+        }
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        const response = await this.request({
+            path: `/v1/leaderboard/head-to-head`,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => V1GetHeadToHeadMatrixResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * Gets the votes between every pair of models.
+     */
+    async arenaGetHeadToHeadMatrix(requestParameters: ArenaGetHeadToHeadMatrixRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<V1GetHeadToHeadMatrixResponse> {
+        const response = await this.arenaGetHeadToHeadMatrixRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
     /**
      * Gets the leaderboard of joke models.
      */
-    async arenaGetLeaderboardRaw(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<V1GetLeaderboardResponse>> {
+    async arenaGetLeaderboardRaw(requestParameters: ArenaGetLeaderboardRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<V1GetLeaderboardResponse>> {
         const queryParameters: any = {};
 
+        if (requestParameters['themeSet'] != null) {
+            queryParameters['themeSet'] = requestParameters['themeSet'];
+        }
+
+        if (requestParameters['policy'] != null) {
+            queryParameters['policy'] = requestParameters['policy'];
+        }
+
+        if (requestParameters['startTime'] != null) {
+            queryParameters['startTime'] = (requestParameters['startTime'] as any).toISOString();
+        }
+
+        if (requestParameters['endTime'] != null) {
+            queryParameters['endTime'] = (requestParameters['endTime'] as any).toISOString();
+        }
+
+        if (requestParameters['excludeKnown'] != null) {
+            queryParameters['excludeKnown'] = requestParameters['excludeKnown'];
+        }
+
         const headerParameters: runtime.HTTPHeaders = {};
 
         const response = await this.request({
@@ -99,17 +376,161 @@ export class ArenaApi extends runtime.BaseAPI {
     /**
      * Gets the leaderboard of joke models.
      */
-    async arenaGetLeaderboard(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<V1GetLeaderboardResponse> {
-        const response = await this.arenaGetLeaderboardRaw(initOverrides);
+    async arenaGetLeaderboard(requestParameters: ArenaGetLeaderboardRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<V1GetLeaderboardResponse> {
+        const response = await this.arenaGetLeaderboardRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Gets a leaderboard snapshot by its ID.
+     */
+    async arenaGetLeaderboardSnapshotRaw(requestParameters: ArenaGetLeaderboardSnapshotRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<V1GetLeaderboardResponse>> {
+        if (requestParameters['id'] == null) {
+            throw new runtime.RequiredError(
+                'id',
+                'Required parameter "id" was null or undefined when calling arenaGetLeaderboardSnapshot().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        const response = await this.request({
+            path: `/v1/leaderboard/snapshots/{id}`.replace(`{${"id"}}`, encodeURIComponent(String(requestParameters['id']))),
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => V1GetLeaderboardResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * Gets a leaderboard snapshot by its ID.
+     */
+    async arenaGetLeaderboardSnapshot(requestParameters: ArenaGetLeaderboardSnapshotRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<V1GetLeaderboardResponse> {
+        const response = await this.arenaGetLeaderboardSnapshotRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Gets the score history of a model across leaderboard snapshots.
+     */
+    async arenaGetModelHistoryRaw(requestParameters: ArenaGetModelHistoryRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<V1GetModelHistoryResponse>> {
+        if (requestParameters['model'] == null) {
+            throw new runtime.RequiredError(
+                'model',
+                'Required parameter "model" was null or undefined when calling arenaGetModelHistory().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        if (requestParameters['startTime'] != null) {
+            queryParameters['startTime'] = (requestParameters['startTime'] as any).toISOString();
+        }
+
+        if (requestParameters['endTime'] != null) {
+            queryParameters['endTime'] = (requestParameters['endTime'] as any).toISOString();
+        }
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        const response = await this.request({
+            path: `/v1/leaderboard/models/{model}/history`.replace(`{${"model"}}`, encodeURIComponent(String(requestParameters['model']))),
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => V1GetModelHistoryResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * Gets the score history of a model across leaderboard snapshots.
+     */
+    async arenaGetModelHistory(requestParameters: ArenaGetModelHistoryRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<V1GetModelHistoryResponse> {
+        const response = await this.arenaGetModelHistoryRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Reports how much the screen side affects votes.
+     */
+    async arenaGetPositionBiasRaw(requestParameters: ArenaGetPositionBiasRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<V1GetPositionBiasResponse>> {
+        const queryParameters: any = {};
+
+        if (requestParameters['themeSet'] != null) {
+            queryParameters['themeSet'] = requestParameters['themeSet'];
+        }
+
+        if (requestParameters['policy'] != null) {
+            queryParameters['policy'] = requestParameters['policy'];
+        }
+
+        if (requestParameters['startTime'] != null) {
+            queryParameters['startTime'] = (requestParameters['startTime'] as any).toISOString();
+        }
+
+        if (requestParameters['endTime'] != null) {
+            queryParameters['endTime'] = (requestParameters['endTime'] as any).toISOString();
+        }
+
+        if (requestParameters['excludeKnown'] != null) {
+            queryParameters['excludeKnown'] = requestParameters['excludeKnown'];
+        }
+
+        if (requestParameters['randomizedOnly'] != null) {
+            queryParameters['randomizedOnly'] = requestParameters['randomizedOnly'];
+        }
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        const response = await this.request({
+            path: `/v1/leaderboard/position-bias`,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => V1GetPositionBiasResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * Reports how much the screen side affects votes.
+     */
+    async arenaGetPositionBias(requestParameters: ArenaGetPositionBiasRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<V1GetPositionBiasResponse> {
+        const response = await this.arenaGetPositionBiasRaw(requestParameters, initOverrides);
         return await response.value();
     }
 
     /**
      * Gets the top jokes.
      */
-    async arenaGetTopJokesRaw(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<V1GetTopJokesResponse>> {
+    async arenaGetTopJokesRaw(requestParameters: ArenaGetTopJokesRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<V1GetTopJokesResponse>> {
         const queryParameters: any = {};
 
+        if (requestParameters['pageSize'] != null) {
+            queryParameters['pageSize'] = requestParameters['pageSize'];
+        }
+
+        if (requestParameters['pageToken'] != null) {
+            queryParameters['pageToken'] = requestParameters['pageToken'];
+        }
+
+        if (requestParameters['model'] != null) {
+            queryParameters['model'] = requestParameters['model'];
+        }
+
+        if (requestParameters['themeId'] != null) {
+            queryParameters['themeId'] = requestParameters['themeId'];
+        }
+
+        if (requestParameters['theme'] != null) {
+            queryParameters['theme'] = requestParameters['theme'];
+        }
+
         const headerParameters: runtime.HTTPHeaders = {};
 
         const response = await this.request({
@@ -125,15 +546,57 @@ export class ArenaApi extends runtime.BaseAPI {
     /**
      * Gets the top jokes.
      */
-    async arenaGetTopJokes(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<V1GetTopJokesResponse> {
-        const response = await this.arenaGetTopJokesRaw(initOverrides);
+    async arenaGetTopJokes(requestParameters: ArenaGetTopJokesRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<V1GetTopJokesResponse> {
+        const response = await this.arenaGetTopJokesRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Lists stored leaderboard snapshots, newest first.
+     */
+    async arenaListLeaderboardSnapshotsRaw(requestParameters: ArenaListLeaderboardSnapshotsRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<V1ListLeaderboardSnapshotsResponse>> {
+        const queryParameters: any = {};
+
+        if (requestParameters['pageSize'] != null) {
+            queryParameters['pageSize'] = requestParameters['pageSize'];
+        }
+
+        if (requestParameters['pageToken'] != null) {
+            queryParameters['pageToken'] = requestParameters['pageToken'];
+        }
+
+        if (requestParameters['startTime'] != null) {
+            queryParameters['startTime'] = (requestParameters['startTime'] as any).toISOString();
+        }
+
+        if (requestParameters['endTime'] != null) {
+            queryParameters['endTime'] = (requestParameters['endTime'] as any).toISOString();
+        }
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        const response = await this.request({
+            path: `/v1/leaderboard/snapshots`,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => V1ListLeaderboardSnapshotsResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * Lists stored leaderboard snapshots, newest first.
+     */
+    async arenaListLeaderboardSnapshots(requestParameters: ArenaListLeaderboardSnapshotsRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<V1ListLeaderboardSnapshotsResponse> {
+        const response = await this.arenaListLeaderboardSnapshotsRaw(requestParameters, initOverrides);
         return await response.value();
     }
 
     /**
      * Submits the user\'s choice between two jokes.
      */
-    async arenaRateChoicesRaw(requestParameters: ArenaRateChoicesRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<object>> {
+    async arenaRateChoicesRaw(requestParameters: ArenaRateChoicesRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<V1RateChoicesResponse>> {
         if (requestParameters['id'] == null) {
             throw new runtime.RequiredError(
                 'id',
@@ -162,13 +625,13 @@ export class ArenaApi extends runtime.BaseAPI {
             body: ArenaRateChoicesBodyToJSON(requestParameters['body']),
         }, initOverrides);
 
-        return new runtime.JSONApiResponse<any>(response);
+        return new runtime.JSONApiResponse(response, (jsonValue) => V1RateChoicesResponseFromJSON(jsonValue));
     }
 
     /**
      * Submits the user\'s choice between two jokes.
      */
-    async arenaRateChoices(requestParameters: ArenaRateChoicesRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<object> {
+    async arenaRateChoices(requestParameters: ArenaRateChoicesRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<V1RateChoicesResponse> {
         const response = await this.arenaRateChoicesRaw(requestParameters, initOverrides);
         return await response.value();
     }
@@ -38,6 +38,12 @@ export interface ArenaRateChoicesBody {
      * @memberof ArenaRateChoicesBody
      */
     known?: V1Winner;
+    /**
+     * Session the pair was served to; must match GetChoicesRequest.session_id.
+     * @type {string}
+     * @memberof ArenaRateChoicesBody
+     */
+    sessionId?: string;
 }
 
 
@@ -61,6 +67,7 @@ export function ArenaRateChoicesBodyFromJSONTyped(json: any, ignoreDiscriminator
         
         'winner': json['winner'] == null ? undefined : V1WinnerFromJSON(json['winner']),
         'known': json['known'] == null ? undefined : V1WinnerFromJSON(json['known']),
+        'sessionId': json['sessionId'] == null ? undefined : json['sessionId'],
     };
 }
 
@@ -72,6 +79,7 @@ export function ArenaRateChoicesBodyToJSON(value?: ArenaRateChoicesBody | null):
         
         'winner': V1WinnerToJSON(value['winner']),
         'known': V1WinnerToJSON(value['known']),
+        'sessionId': value['sessionId'],
     };
 }
 
@@ -0,0 +1,90 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * proto/server.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { V1LeaderboardDiffEntry } from './V1LeaderboardDiffEntry';
+import {
+    V1LeaderboardDiffEntryFromJSON,
+    V1LeaderboardDiffEntryFromJSONTyped,
+    V1LeaderboardDiffEntryToJSON,
+} from './V1LeaderboardDiffEntry';
+import type { V1LeaderboardSnapshot } from './V1LeaderboardSnapshot';
+import {
+    V1LeaderboardSnapshotFromJSON,
+    V1LeaderboardSnapshotFromJSONTyped,
+    V1LeaderboardSnapshotToJSON,
+} from './V1LeaderboardSnapshot';
+
+/**
+ * DiffLeaderboardSnapshotsResponse contains per-model changes ordered by the
+ * target rank.
+ * @export
+ * @interface V1DiffLeaderboardSnapshotsResponse
+ */
+export interface V1DiffLeaderboardSnapshotsResponse {
+    /**
+     * 
+     * @type {V1LeaderboardSnapshot}
+     * @memberof V1DiffLeaderboardSnapshotsResponse
+     */
+    base?: V1LeaderboardSnapshot;
+    /**
+     * 
+     * @type {V1LeaderboardSnapshot}
+     * @memberof V1DiffLeaderboardSnapshotsResponse
+     */
+    target?: V1LeaderboardSnapshot;
+    /**
+     * 
+     * @type {Array<V1LeaderboardDiffEntry>}
+     * @memberof V1DiffLeaderboardSnapshotsResponse
+     */
+    entries?: Array<V1LeaderboardDiffEntry>;
+}
+
+/**
+ * Check if a given object implements the V1DiffLeaderboardSnapshotsResponse interface.
+ */
+export function instanceOfV1DiffLeaderboardSnapshotsResponse(value: object): value is V1DiffLeaderboardSnapshotsResponse {
+    return true;
+}
+
+export function V1DiffLeaderboardSnapshotsResponseFromJSON(json: any): V1DiffLeaderboardSnapshotsResponse {
+    return V1DiffLeaderboardSnapshotsResponseFromJSONTyped(json, false);
+}
+
+export function V1DiffLeaderboardSnapshotsResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean): V1DiffLeaderboardSnapshotsResponse {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'base': json['base'] == null ? undefined : V1LeaderboardSnapshotFromJSON(json['base']),
+        'target': json['target'] == null ? undefined : V1LeaderboardSnapshotFromJSON(json['target']),
+        'entries': json['entries'] == null ? undefined : ((json['entries'] as Array<any>).map(V1LeaderboardDiffEntryFromJSON)),
+    };
+}
+
+export function V1DiffLeaderboardSnapshotsResponseToJSON(value?: V1DiffLeaderboardSnapshotsResponse | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'base': V1LeaderboardSnapshotToJSON(value['base']),
+        'target': V1LeaderboardSnapshotToJSON(value['target']),
+        'entries': value['entries'] == null ? undefined : ((value['entries'] as Array<any>).map(V1LeaderboardDiffEntryToJSON)),
+    };
+}
+
@@ -43,6 +43,12 @@ export interface V1GetChoicesResponse {
      * @memberof V1GetChoicesResponse
      */
     rightJoke?: string;
+    /**
+     * ID of the theme.
+     * @type {string}
+     * @memberof V1GetChoicesResponse
+     */
+    themeId?: string;
 }
 
 /**
@@ -66,6 +72,7 @@ export function V1GetChoicesResponseFromJSONTyped(json: any, ignoreDiscriminator
         'theme': json['theme'] == null ? undefined : json['theme'],
         'leftJoke': json['leftJoke'] == null ? undefined : json['leftJoke'],
         'rightJoke': json['rightJoke'] == null ? undefined : json['rightJoke'],
+        'themeId': json['themeId'] == null ? undefined : json['themeId'],
     };
 }
 
@@ -79,6 +86,7 @@ export function V1GetChoicesResponseToJSON(value?: V1GetChoicesResponse | null):
         'theme': value['theme'],
         'leftJoke': value['leftJoke'],
         'rightJoke': value['rightJoke'],
+        'themeId': value['themeId'],
     };
 }
 
@@ -0,0 +1,76 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * proto/server.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { V1HeadToHead } from './V1HeadToHead';
+import {
+    V1HeadToHeadFromJSON,
+    V1HeadToHeadFromJSONTyped,
+    V1HeadToHeadToJSON,
+} from './V1HeadToHead';
+
+/**
+ * GetHeadToHeadMatrixResponse contains every pair of models that met at least
+ * once. Each pair is listed once with model_a sorted before model_b.
+ * @export
+ * @interface V1GetHeadToHeadMatrixResponse
+ */
+export interface V1GetHeadToHeadMatrixResponse {
+    /**
+     * All models that appear in pairs, sorted.
+     * @type {Array<string>}
+     * @memberof V1GetHeadToHeadMatrixResponse
+     */
+    models?: Array<string>;
+    /**
+     * 
+     * @type {Array<V1HeadToHead>}
+     * @memberof V1GetHeadToHeadMatrixResponse
+     */
+    pairs?: Array<V1HeadToHead>;
+}
+
+/**
+ * Check if a given object implements the V1GetHeadToHeadMatrixResponse interface.
+ */
+export function instanceOfV1GetHeadToHeadMatrixResponse(value: object): value is V1GetHeadToHeadMatrixResponse {
+    return true;
+}
+
+export function V1GetHeadToHeadMatrixResponseFromJSON(json: any): V1GetHeadToHeadMatrixResponse {
+    return V1GetHeadToHeadMatrixResponseFromJSONTyped(json, false);
+}
+
+export function V1GetHeadToHeadMatrixResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean): V1GetHeadToHeadMatrixResponse {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'models': json['models'] == null ? undefined : json['models'],
+        'pairs': json['pairs'] == null ? undefined : ((json['pairs'] as Array<any>).map(V1HeadToHeadFromJSON)),
+    };
+}
+
+export function V1GetHeadToHeadMatrixResponseToJSON(value?: V1GetHeadToHeadMatrixResponse | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'models': value['models'],
+        'pairs': value['pairs'] == null ? undefined : ((value['pairs'] as Array<any>).map(V1HeadToHeadToJSON)),
+    };
+}
+
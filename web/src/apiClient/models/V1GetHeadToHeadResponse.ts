@@ -0,0 +1,67 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * proto/server.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { V1HeadToHead } from './V1HeadToHead';
+import {
+    V1HeadToHeadFromJSON,
+    V1HeadToHeadFromJSONTyped,
+    V1HeadToHeadToJSON,
+} from './V1HeadToHead';
+
+/**
+ * GetHeadToHeadResponse contains the votes between the two models.
+ * @export
+ * @interface V1GetHeadToHeadResponse
+ */
+export interface V1GetHeadToHeadResponse {
+    /**
+     * 
+     * @type {V1HeadToHead}
+     * @memberof V1GetHeadToHeadResponse
+     */
+    headToHead?: V1HeadToHead;
+}
+
+/**
+ * Check if a given object implements the V1GetHeadToHeadResponse interface.
+ */
+export function instanceOfV1GetHeadToHeadResponse(value: object): value is V1GetHeadToHeadResponse {
+    return true;
+}
+
+export function V1GetHeadToHeadResponseFromJSON(json: any): V1GetHeadToHeadResponse {
+    return V1GetHeadToHeadResponseFromJSONTyped(json, false);
+}
+
+export function V1GetHeadToHeadResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean): V1GetHeadToHeadResponse {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'headToHead': json['headToHead'] == null ? undefined : V1HeadToHeadFromJSON(json['headToHead']),
+    };
+}
+
+export function V1GetHeadToHeadResponseToJSON(value?: V1GetHeadToHeadResponse | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'headToHead': V1HeadToHeadToJSON(value['headToHead']),
+    };
+}
+
@@ -32,6 +32,18 @@ export interface V1GetLeaderboardResponse {
      * @memberof V1GetLeaderboardResponse
      */
     entries?: Array<V1LeaderboardEntry>;
+    /**
+     * ID of the snapshot; empty for leaderboards computed on the fly.
+     * @type {string}
+     * @memberof V1GetLeaderboardResponse
+     */
+    id?: string;
+    /**
+     * Time the leaderboard was computed.
+     * @type {Date}
+     * @memberof V1GetLeaderboardResponse
+     */
+    createdAt?: Date;
 }
 
 /**
@@ -52,6 +64,8 @@ export function V1GetLeaderboardResponseFromJSONTyped(json: any, ignoreDiscrimin
     return {
         
         'entries': json['entries'] == null ? undefined : ((json['entries'] as Array<any>).map(V1LeaderboardEntryFromJSON)),
+        'id': json['id'] == null ? undefined : json['id'],
+        'createdAt': json['createdAt'] == null ? undefined : (new Date(json['createdAt'])),
     };
 }
 
@@ -62,6 +76,8 @@ export function V1GetLeaderboardResponseToJSON(value?: V1GetLeaderboardResponse
     return {
         
         'entries': value['entries'] == null ? undefined : ((value['entries'] as Array<any>).map(V1LeaderboardEntryToJSON)),
+        'id': value['id'],
+        'createdAt': value['createdAt'] == null ? undefined : ((value['createdAt']).toISOString()),
     };
 }
 
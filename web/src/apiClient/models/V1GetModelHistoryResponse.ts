@@ -0,0 +1,68 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * proto/server.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { V1ModelHistoryPoint } from './V1ModelHistoryPoint';
+import {
+    V1ModelHistoryPointFromJSON,
+    V1ModelHistoryPointFromJSONTyped,
+    V1ModelHistoryPointToJSON,
+} from './V1ModelHistoryPoint';
+
+/**
+ * GetModelHistoryResponse contains the model's history, oldest first. Only
+ * the 1000 most recent snapshots in the requested range are included.
+ * @export
+ * @interface V1GetModelHistoryResponse
+ */
+export interface V1GetModelHistoryResponse {
+    /**
+     * 
+     * @type {Array<V1ModelHistoryPoint>}
+     * @memberof V1GetModelHistoryResponse
+     */
+    points?: Array<V1ModelHistoryPoint>;
+}
+
+/**
+ * Check if a given object implements the V1GetModelHistoryResponse interface.
+ */
+export function instanceOfV1GetModelHistoryResponse(value: object): value is V1GetModelHistoryResponse {
+    return true;
+}
+
+export function V1GetModelHistoryResponseFromJSON(json: any): V1GetModelHistoryResponse {
+    return V1GetModelHistoryResponseFromJSONTyped(json, false);
+}
+
+export function V1GetModelHistoryResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean): V1GetModelHistoryResponse {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'points': json['points'] == null ? undefined : ((json['points'] as Array<any>).map(V1ModelHistoryPointFromJSON)),
+    };
+}
+
+export function V1GetModelHistoryResponseToJSON(value?: V1GetModelHistoryResponse | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'points': value['points'] == null ? undefined : ((value['points'] as Array<any>).map(V1ModelHistoryPointToJSON)),
+    };
+}
+
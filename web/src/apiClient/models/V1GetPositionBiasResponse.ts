@@ -0,0 +1,99 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * proto/server.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { V1HeadToHeadRecord } from './V1HeadToHeadRecord';
+import {
+    V1HeadToHeadRecordFromJSON,
+    V1HeadToHeadRecordFromJSONTyped,
+    V1HeadToHeadRecordToJSON,
+} from './V1HeadToHeadRecord';
+import type { V1PositionBiasEntry } from './V1PositionBiasEntry';
+import {
+    V1PositionBiasEntryFromJSON,
+    V1PositionBiasEntryFromJSONTyped,
+    V1PositionBiasEntryToJSON,
+} from './V1PositionBiasEntry';
+
+/**
+ * GetPositionBiasResponse is the position bias report.
+ * @export
+ * @interface V1GetPositionBiasResponse
+ */
+export interface V1GetPositionBiasResponse {
+    /**
+     * 
+     * @type {V1HeadToHeadRecord}
+     * @memberof V1GetPositionBiasResponse
+     */
+    left?: V1HeadToHeadRecord;
+    /**
+     * Standard error of the left win rate.
+     * @type {number}
+     * @memberof V1GetPositionBiasResponse
+     */
+    leftWinRateStderr?: number;
+    /**
+     * Fitted odds multiplier of the left side: a left joke with score a beats a
+     * right joke with score b with probability advantage*a / (advantage*a + b).
+     * One means there is no position bias.
+     * @type {number}
+     * @memberof V1GetPositionBiasResponse
+     */
+    leftAdvantage?: number;
+    /**
+     * Models ordered by name.
+     * @type {Array<V1PositionBiasEntry>}
+     * @memberof V1GetPositionBiasResponse
+     */
+    entries?: Array<V1PositionBiasEntry>;
+}
+
+/**
+ * Check if a given object implements the V1GetPositionBiasResponse interface.
+ */
+export function instanceOfV1GetPositionBiasResponse(value: object): value is V1GetPositionBiasResponse {
+    return true;
+}
+
+export function V1GetPositionBiasResponseFromJSON(json: any): V1GetPositionBiasResponse {
+    return V1GetPositionBiasResponseFromJSONTyped(json, false);
+}
+
+export function V1GetPositionBiasResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean): V1GetPositionBiasResponse {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'left': json['left'] == null ? undefined : V1HeadToHeadRecordFromJSON(json['left']),
+        'leftWinRateStderr': json['leftWinRateStderr'] == null ? undefined : json['leftWinRateStderr'],
+        'leftAdvantage': json['leftAdvantage'] == null ? undefined : json['leftAdvantage'],
+        'entries': json['entries'] == null ? undefined : ((json['entries'] as Array<any>).map(V1PositionBiasEntryFromJSON)),
+    };
+}
+
+export function V1GetPositionBiasResponseToJSON(value?: V1GetPositionBiasResponse | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'left': V1HeadToHeadRecordToJSON(value['left']),
+        'leftWinRateStderr': value['leftWinRateStderr'],
+        'leftAdvantage': value['leftAdvantage'],
+        'entries': value['entries'] == null ? undefined : ((value['entries'] as Array<any>).map(V1PositionBiasEntryToJSON)),
+    };
+}
+
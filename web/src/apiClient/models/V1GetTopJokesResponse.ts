@@ -32,6 +32,12 @@ export interface V1GetTopJokesResponse {
      * @memberof V1GetTopJokesResponse
      */
     entries?: Array<V1TopJokesEntry>;
+    /**
+     * Token to get the next page, empty on the last one.
+     * @type {string}
+     * @memberof V1GetTopJokesResponse
+     */
+    nextPageToken?: string;
 }
 
 /**
@@ -52,6 +58,7 @@ export function V1GetTopJokesResponseFromJSONTyped(json: any, ignoreDiscriminato
     return {
         
         'entries': json['entries'] == null ? undefined : ((json['entries'] as Array<any>).map(V1TopJokesEntryFromJSON)),
+        'nextPageToken': json['nextPageToken'] == null ? undefined : json['nextPageToken'],
     };
 }
 
@@ -62,6 +69,7 @@ export function V1GetTopJokesResponseToJSON(value?: V1GetTopJokesResponse | null
     return {
         
         'entries': value['entries'] == null ? undefined : ((value['entries'] as Array<any>).map(V1TopJokesEntryToJSON)),
+        'nextPageToken': value['nextPageToken'],
     };
 }
 
@@ -0,0 +1,111 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * proto/server.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { V1HeadToHeadExample } from './V1HeadToHeadExample';
+import {
+    V1HeadToHeadExampleFromJSON,
+    V1HeadToHeadExampleFromJSONTyped,
+    V1HeadToHeadExampleToJSON,
+} from './V1HeadToHeadExample';
+import type { V1HeadToHeadRecord } from './V1HeadToHeadRecord';
+import {
+    V1HeadToHeadRecordFromJSON,
+    V1HeadToHeadRecordFromJSONTyped,
+    V1HeadToHeadRecordToJSON,
+} from './V1HeadToHeadRecord';
+import type { V1ThemeHeadToHead } from './V1ThemeHeadToHead';
+import {
+    V1ThemeHeadToHeadFromJSON,
+    V1ThemeHeadToHeadFromJSONTyped,
+    V1ThemeHeadToHeadToJSON,
+} from './V1ThemeHeadToHead';
+
+/**
+ * HeadToHead summarizes the votes between two models.
+ * @export
+ * @interface V1HeadToHead
+ */
+export interface V1HeadToHead {
+    /**
+     * 
+     * @type {string}
+     * @memberof V1HeadToHead
+     */
+    modelA?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof V1HeadToHead
+     */
+    modelB?: string;
+    /**
+     * 
+     * @type {V1HeadToHeadRecord}
+     * @memberof V1HeadToHead
+     */
+    record?: V1HeadToHeadRecord;
+    /**
+     * Per-theme breakdown, most voted theme first.
+     * @type {Array<V1ThemeHeadToHead>}
+     * @memberof V1HeadToHead
+     */
+    themes?: Array<V1ThemeHeadToHead>;
+    /**
+     * Most recently rated pairs, newest first.
+     * @type {Array<V1HeadToHeadExample>}
+     * @memberof V1HeadToHead
+     */
+    examples?: Array<V1HeadToHeadExample>;
+}
+
+/**
+ * Check if a given object implements the V1HeadToHead interface.
+ */
+export function instanceOfV1HeadToHead(value: object): value is V1HeadToHead {
+    return true;
+}
+
+export function V1HeadToHeadFromJSON(json: any): V1HeadToHead {
+    return V1HeadToHeadFromJSONTyped(json, false);
+}
+
+export function V1HeadToHeadFromJSONTyped(json: any, ignoreDiscriminator: boolean): V1HeadToHead {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'modelA': json['modelA'] == null ? undefined : json['modelA'],
+        'modelB': json['modelB'] == null ? undefined : json['modelB'],
+        'record': json['record'] == null ? undefined : V1HeadToHeadRecordFromJSON(json['record']),
+        'themes': json['themes'] == null ? undefined : ((json['themes'] as Array<any>).map(V1ThemeHeadToHeadFromJSON)),
+        'examples': json['examples'] == null ? undefined : ((json['examples'] as Array<any>).map(V1HeadToHeadExampleFromJSON)),
+    };
+}
+
+export function V1HeadToHeadToJSON(value?: V1HeadToHead | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'modelA': value['modelA'],
+        'modelB': value['modelB'],
+        'record': V1HeadToHeadRecordToJSON(value['record']),
+        'themes': value['themes'] == null ? undefined : ((value['themes'] as Array<any>).map(V1ThemeHeadToHeadToJSON)),
+        'examples': value['examples'] == null ? undefined : ((value['examples'] as Array<any>).map(V1HeadToHeadExampleToJSON)),
+    };
+}
+
@@ -0,0 +1,109 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * proto/server.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { V1Winner } from './V1Winner';
+import {
+    V1WinnerFromJSON,
+    V1WinnerFromJSONTyped,
+    V1WinnerToJSON,
+} from './V1Winner';
+
+/**
+ * HeadToHeadExample is a rated pair of jokes by model_a and model_b.
+ * @export
+ * @interface V1HeadToHeadExample
+ */
+export interface V1HeadToHeadExample {
+    /**
+     * 
+     * @type {string}
+     * @memberof V1HeadToHeadExample
+     */
+    themeId?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof V1HeadToHeadExample
+     */
+    theme?: string;
+    /**
+     * Joke by model_a.
+     * @type {string}
+     * @memberof V1HeadToHeadExample
+     */
+    jokeA?: string;
+    /**
+     * Joke by model_b.
+     * @type {string}
+     * @memberof V1HeadToHeadExample
+     */
+    jokeB?: string;
+    /**
+     * 
+     * @type {V1Winner}
+     * @memberof V1HeadToHeadExample
+     */
+    winner?: V1Winner;
+    /**
+     * 
+     * @type {Date}
+     * @memberof V1HeadToHeadExample
+     */
+    ratedAt?: Date;
+}
+
+
+
+/**
+ * Check if a given object implements the V1HeadToHeadExample interface.
+ */
+export function instanceOfV1HeadToHeadExample(value: object): value is V1HeadToHeadExample {
+    return true;
+}
+
+export function V1HeadToHeadExampleFromJSON(json: any): V1HeadToHeadExample {
+    return V1HeadToHeadExampleFromJSONTyped(json, false);
+}
+
+export function V1HeadToHeadExampleFromJSONTyped(json: any, ignoreDiscriminator: boolean): V1HeadToHeadExample {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'themeId': json['themeId'] == null ? undefined : json['themeId'],
+        'theme': json['theme'] == null ? undefined : json['theme'],
+        'jokeA': json['jokeA'] == null ? undefined : json['jokeA'],
+        'jokeB': json['jokeB'] == null ? undefined : json['jokeB'],
+        'winner': json['winner'] == null ? undefined : V1WinnerFromJSON(json['winner']),
+        'ratedAt': json['ratedAt'] == null ? undefined : (new Date(json['ratedAt'])),
+    };
+}
+
+export function V1HeadToHeadExampleToJSON(value?: V1HeadToHeadExample | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'themeId': value['themeId'],
+        'theme': value['theme'],
+        'jokeA': value['jokeA'],
+        'jokeB': value['jokeB'],
+        'winner': V1WinnerToJSON(value['winner']),
+        'ratedAt': value['ratedAt'] == null ? undefined : ((value['ratedAt']).toISOString()),
+    };
+}
+
@@ -0,0 +1,101 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * proto/server.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * HeadToHeadRecord counts votes between two models from model_a's side.
+ * @export
+ * @interface V1HeadToHeadRecord
+ */
+export interface V1HeadToHeadRecord {
+    /**
+     * Total votes, including both-bad ones.
+     * @type {string}
+     * @memberof V1HeadToHeadRecord
+     */
+    votes?: string;
+    /**
+     * Votes for model_a's joke.
+     * @type {string}
+     * @memberof V1HeadToHeadRecord
+     */
+    wins?: string;
+    /**
+     * Votes for model_b's joke.
+     * @type {string}
+     * @memberof V1HeadToHeadRecord
+     */
+    losses?: string;
+    /**
+     * Votes where both jokes were liked.
+     * @type {string}
+     * @memberof V1HeadToHeadRecord
+     */
+    ties?: string;
+    /**
+     * Votes where neither joke was liked.
+     * @type {string}
+     * @memberof V1HeadToHeadRecord
+     */
+    bothBad?: string;
+    /**
+     * (wins + ties / 2) / (wins + losses + ties). Both-bad votes are ignored,
+     * as in the ratings.
+     * @type {number}
+     * @memberof V1HeadToHeadRecord
+     */
+    winRate?: number;
+}
+
+/**
+ * Check if a given object implements the V1HeadToHeadRecord interface.
+ */
+export function instanceOfV1HeadToHeadRecord(value: object): value is V1HeadToHeadRecord {
+    return true;
+}
+
+export function V1HeadToHeadRecordFromJSON(json: any): V1HeadToHeadRecord {
+    return V1HeadToHeadRecordFromJSONTyped(json, false);
+}
+
+export function V1HeadToHeadRecordFromJSONTyped(json: any, ignoreDiscriminator: boolean): V1HeadToHeadRecord {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'votes': json['votes'] == null ? undefined : json['votes'],
+        'wins': json['wins'] == null ? undefined : json['wins'],
+        'losses': json['losses'] == null ? undefined : json['losses'],
+        'ties': json['ties'] == null ? undefined : json['ties'],
+        'bothBad': json['bothBad'] == null ? undefined : json['bothBad'],
+        'winRate': json['winRate'] == null ? undefined : json['winRate'],
+    };
+}
+
+export function V1HeadToHeadRecordToJSON(value?: V1HeadToHeadRecord | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'votes': value['votes'],
+        'wins': value['wins'],
+        'losses': value['losses'],
+        'ties': value['ties'],
+        'bothBad': value['bothBad'],
+        'winRate': value['winRate'],
+    };
+}
+
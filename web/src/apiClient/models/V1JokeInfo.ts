@@ -0,0 +1,76 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * proto/server.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * JokeInfo contains the generation parameters of a joke.
+ * @export
+ * @interface V1JokeInfo
+ */
+export interface V1JokeInfo {
+    /**
+     * Public model name, as in LeaderboardEntry.model.
+     * @type {string}
+     * @memberof V1JokeInfo
+     */
+    model?: string;
+    /**
+     * Generation policy.
+     * @type {string}
+     * @memberof V1JokeInfo
+     */
+    policy?: string;
+    /**
+     * Theme set of the joke.
+     * @type {string}
+     * @memberof V1JokeInfo
+     */
+    themeSet?: string;
+}
+
+/**
+ * Check if a given object implements the V1JokeInfo interface.
+ */
+export function instanceOfV1JokeInfo(value: object): value is V1JokeInfo {
+    return true;
+}
+
+export function V1JokeInfoFromJSON(json: any): V1JokeInfo {
+    return V1JokeInfoFromJSONTyped(json, false);
+}
+
+export function V1JokeInfoFromJSONTyped(json: any, ignoreDiscriminator: boolean): V1JokeInfo {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'model': json['model'] == null ? undefined : json['model'],
+        'policy': json['policy'] == null ? undefined : json['policy'],
+        'themeSet': json['themeSet'] == null ? undefined : json['themeSet'],
+    };
+}
+
+export function V1JokeInfoToJSON(value?: V1JokeInfo | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'model': value['model'],
+        'policy': value['policy'],
+        'themeSet': value['themeSet'],
+    };
+}
+
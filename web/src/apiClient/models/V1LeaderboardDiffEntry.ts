@@ -0,0 +1,125 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * proto/server.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * LeaderboardDiffEntry describes how a model changed between two snapshots.
+ * Ranks are 1-based by Elo score, 0 if the model is absent from a snapshot.
+ * @export
+ * @interface V1LeaderboardDiffEntry
+ */
+export interface V1LeaderboardDiffEntry {
+    /**
+     * 
+     * @type {string}
+     * @memberof V1LeaderboardDiffEntry
+     */
+    model?: string;
+    /**
+     * 
+     * @type {number}
+     * @memberof V1LeaderboardDiffEntry
+     */
+    baseRank?: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof V1LeaderboardDiffEntry
+     */
+    targetRank?: number;
+    /**
+     * Positive when the model moved up.
+     * @type {number}
+     * @memberof V1LeaderboardDiffEntry
+     */
+    rankChange?: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof V1LeaderboardDiffEntry
+     */
+    eloScoreDelta?: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof V1LeaderboardDiffEntry
+     */
+    newmanScoreDelta?: number;
+    /**
+     * 
+     * @type {string}
+     * @memberof V1LeaderboardDiffEntry
+     */
+    votesDelta?: string;
+    /**
+     * The model is only present in the target snapshot.
+     * @type {boolean}
+     * @memberof V1LeaderboardDiffEntry
+     */
+    added?: boolean;
+    /**
+     * The model is only present in the base snapshot.
+     * @type {boolean}
+     * @memberof V1LeaderboardDiffEntry
+     */
+    removed?: boolean;
+}
+
+/**
+ * Check if a given object implements the V1LeaderboardDiffEntry interface.
+ */
+export function instanceOfV1LeaderboardDiffEntry(value: object): value is V1LeaderboardDiffEntry {
+    return true;
+}
+
+export function V1LeaderboardDiffEntryFromJSON(json: any): V1LeaderboardDiffEntry {
+    return V1LeaderboardDiffEntryFromJSONTyped(json, false);
+}
+
+export function V1LeaderboardDiffEntryFromJSONTyped(json: any, ignoreDiscriminator: boolean): V1LeaderboardDiffEntry {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'model': json['model'] == null ? undefined : json['model'],
+        'baseRank': json['baseRank'] == null ? undefined : json['baseRank'],
+        'targetRank': json['targetRank'] == null ? undefined : json['targetRank'],
+        'rankChange': json['rankChange'] == null ? undefined : json['rankChange'],
+        'eloScoreDelta': json['eloScoreDelta'] == null ? undefined : json['eloScoreDelta'],
+        'newmanScoreDelta': json['newmanScoreDelta'] == null ? undefined : json['newmanScoreDelta'],
+        'votesDelta': json['votesDelta'] == null ? undefined : json['votesDelta'],
+        'added': json['added'] == null ? undefined : json['added'],
+        'removed': json['removed'] == null ? undefined : json['removed'],
+    };
+}
+
+export function V1LeaderboardDiffEntryToJSON(value?: V1LeaderboardDiffEntry | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'model': value['model'],
+        'baseRank': value['baseRank'],
+        'targetRank': value['targetRank'],
+        'rankChange': value['rankChange'],
+        'eloScoreDelta': value['eloScoreDelta'],
+        'newmanScoreDelta': value['newmanScoreDelta'],
+        'votesDelta': value['votesDelta'],
+        'added': value['added'],
+        'removed': value['removed'],
+    };
+}
+
@@ -0,0 +1,76 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * proto/server.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * LeaderboardSnapshot summarizes a stored leaderboard.
+ * @export
+ * @interface V1LeaderboardSnapshot
+ */
+export interface V1LeaderboardSnapshot {
+    /**
+     * 
+     * @type {string}
+     * @memberof V1LeaderboardSnapshot
+     */
+    id?: string;
+    /**
+     * 
+     * @type {Date}
+     * @memberof V1LeaderboardSnapshot
+     */
+    createdAt?: Date;
+    /**
+     * Number of ranked models.
+     * @type {number}
+     * @memberof V1LeaderboardSnapshot
+     */
+    models?: number;
+}
+
+/**
+ * Check if a given object implements the V1LeaderboardSnapshot interface.
+ */
+export function instanceOfV1LeaderboardSnapshot(value: object): value is V1LeaderboardSnapshot {
+    return true;
+}
+
+export function V1LeaderboardSnapshotFromJSON(json: any): V1LeaderboardSnapshot {
+    return V1LeaderboardSnapshotFromJSONTyped(json, false);
+}
+
+export function V1LeaderboardSnapshotFromJSONTyped(json: any, ignoreDiscriminator: boolean): V1LeaderboardSnapshot {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'id': json['id'] == null ? undefined : json['id'],
+        'createdAt': json['createdAt'] == null ? undefined : (new Date(json['createdAt'])),
+        'models': json['models'] == null ? undefined : json['models'],
+    };
+}
+
+export function V1LeaderboardSnapshotToJSON(value?: V1LeaderboardSnapshot | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'id': value['id'],
+        'createdAt': value['createdAt'] == null ? undefined : ((value['createdAt']).toISOString()),
+        'models': value['models'],
+    };
+}
+
@@ -0,0 +1,75 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * proto/server.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { V1LeaderboardSnapshot } from './V1LeaderboardSnapshot';
+import {
+    V1LeaderboardSnapshotFromJSON,
+    V1LeaderboardSnapshotFromJSONTyped,
+    V1LeaderboardSnapshotToJSON,
+} from './V1LeaderboardSnapshot';
+
+/**
+ * ListLeaderboardSnapshotsResponse contains a page of snapshots.
+ * @export
+ * @interface V1ListLeaderboardSnapshotsResponse
+ */
+export interface V1ListLeaderboardSnapshotsResponse {
+    /**
+     * 
+     * @type {Array<V1LeaderboardSnapshot>}
+     * @memberof V1ListLeaderboardSnapshotsResponse
+     */
+    snapshots?: Array<V1LeaderboardSnapshot>;
+    /**
+     * Token for the next page; empty on the last page.
+     * @type {string}
+     * @memberof V1ListLeaderboardSnapshotsResponse
+     */
+    nextPageToken?: string;
+}
+
+/**
+ * Check if a given object implements the V1ListLeaderboardSnapshotsResponse interface.
+ */
+export function instanceOfV1ListLeaderboardSnapshotsResponse(value: object): value is V1ListLeaderboardSnapshotsResponse {
+    return true;
+}
+
+export function V1ListLeaderboardSnapshotsResponseFromJSON(json: any): V1ListLeaderboardSnapshotsResponse {
+    return V1ListLeaderboardSnapshotsResponseFromJSONTyped(json, false);
+}
+
+export function V1ListLeaderboardSnapshotsResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean): V1ListLeaderboardSnapshotsResponse {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'snapshots': json['snapshots'] == null ? undefined : ((json['snapshots'] as Array<any>).map(V1LeaderboardSnapshotFromJSON)),
+        'nextPageToken': json['nextPageToken'] == null ? undefined : json['nextPageToken'],
+    };
+}
+
+export function V1ListLeaderboardSnapshotsResponseToJSON(value?: V1ListLeaderboardSnapshotsResponse | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'snapshots': value['snapshots'] == null ? undefined : ((value['snapshots'] as Array<any>).map(V1LeaderboardSnapshotToJSON)),
+        'nextPageToken': value['nextPageToken'],
+    };
+}
+
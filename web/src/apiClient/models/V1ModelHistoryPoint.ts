@@ -0,0 +1,91 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * proto/server.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { V1LeaderboardEntry } from './V1LeaderboardEntry';
+import {
+    V1LeaderboardEntryFromJSON,
+    V1LeaderboardEntryFromJSONTyped,
+    V1LeaderboardEntryToJSON,
+} from './V1LeaderboardEntry';
+
+/**
+ * ModelHistoryPoint is a model's standing in a single snapshot.
+ * @export
+ * @interface V1ModelHistoryPoint
+ */
+export interface V1ModelHistoryPoint {
+    /**
+     * 
+     * @type {string}
+     * @memberof V1ModelHistoryPoint
+     */
+    snapshotId?: string;
+    /**
+     * 
+     * @type {Date}
+     * @memberof V1ModelHistoryPoint
+     */
+    createdAt?: Date;
+    /**
+     * 1-based rank by Elo score.
+     * @type {number}
+     * @memberof V1ModelHistoryPoint
+     */
+    rank?: number;
+    /**
+     * 
+     * @type {V1LeaderboardEntry}
+     * @memberof V1ModelHistoryPoint
+     */
+    entry?: V1LeaderboardEntry;
+}
+
+/**
+ * Check if a given object implements the V1ModelHistoryPoint interface.
+ */
+export function instanceOfV1ModelHistoryPoint(value: object): value is V1ModelHistoryPoint {
+    return true;
+}
+
+export function V1ModelHistoryPointFromJSON(json: any): V1ModelHistoryPoint {
+    return V1ModelHistoryPointFromJSONTyped(json, false);
+}
+
+export function V1ModelHistoryPointFromJSONTyped(json: any, ignoreDiscriminator: boolean): V1ModelHistoryPoint {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'snapshotId': json['snapshotId'] == null ? undefined : json['snapshotId'],
+        'createdAt': json['createdAt'] == null ? undefined : (new Date(json['createdAt'])),
+        'rank': json['rank'] == null ? undefined : json['rank'],
+        'entry': json['entry'] == null ? undefined : V1LeaderboardEntryFromJSON(json['entry']),
+    };
+}
+
+export function V1ModelHistoryPointToJSON(value?: V1ModelHistoryPoint | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'snapshotId': value['snapshotId'],
+        'createdAt': value['createdAt'] == null ? undefined : ((value['createdAt']).toISOString()),
+        'rank': value['rank'],
+        'entry': V1LeaderboardEntryToJSON(value['entry']),
+    };
+}
+
@@ -0,0 +1,100 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * proto/server.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { V1HeadToHeadRecord } from './V1HeadToHeadRecord';
+import {
+    V1HeadToHeadRecordFromJSON,
+    V1HeadToHeadRecordFromJSONTyped,
+    V1HeadToHeadRecordToJSON,
+} from './V1HeadToHeadRecord';
+
+/**
+ * PositionBiasEntry shows how a model fares on each side of the screen.
+ * @export
+ * @interface V1PositionBiasEntry
+ */
+export interface V1PositionBiasEntry {
+    /**
+     * 
+     * @type {string}
+     * @memberof V1PositionBiasEntry
+     */
+    model?: string;
+    /**
+     * 
+     * @type {V1HeadToHeadRecord}
+     * @memberof V1PositionBiasEntry
+     */
+    left?: V1HeadToHeadRecord;
+    /**
+     * 
+     * @type {V1HeadToHeadRecord}
+     * @memberof V1PositionBiasEntry
+     */
+    right?: V1HeadToHeadRecord;
+    /**
+     * Bradley-Terry score of the model. Scores sum to one.
+     * @type {number}
+     * @memberof V1PositionBiasEntry
+     */
+    score?: number;
+    /**
+     * Bradley-Terry score of the model with the position bias removed.
+     * Scores sum to one.
+     * @type {number}
+     * @memberof V1PositionBiasEntry
+     */
+    correctedScore?: number;
+}
+
+/**
+ * Check if a given object implements the V1PositionBiasEntry interface.
+ */
+export function instanceOfV1PositionBiasEntry(value: object): value is V1PositionBiasEntry {
+    return true;
+}
+
+export function V1PositionBiasEntryFromJSON(json: any): V1PositionBiasEntry {
+    return V1PositionBiasEntryFromJSONTyped(json, false);
+}
+
+export function V1PositionBiasEntryFromJSONTyped(json: any, ignoreDiscriminator: boolean): V1PositionBiasEntry {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'model': json['model'] == null ? undefined : json['model'],
+        'left': json['left'] == null ? undefined : V1HeadToHeadRecordFromJSON(json['left']),
+        'right': json['right'] == null ? undefined : V1HeadToHeadRecordFromJSON(json['right']),
+        'score': json['score'] == null ? undefined : json['score'],
+        'correctedScore': json['correctedScore'] == null ? undefined : json['correctedScore'],
+    };
+}
+
+export function V1PositionBiasEntryToJSON(value?: V1PositionBiasEntry | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'model': value['model'],
+        'left': V1HeadToHeadRecordToJSON(value['left']),
+        'right': V1HeadToHeadRecordToJSON(value['right']),
+        'score': value['score'],
+        'correctedScore': value['correctedScore'],
+    };
+}
+
@@ -0,0 +1,75 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * proto/server.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { V1JokeInfo } from './V1JokeInfo';
+import {
+    V1JokeInfoFromJSON,
+    V1JokeInfoFromJSONTyped,
+    V1JokeInfoToJSON,
+} from './V1JokeInfo';
+
+/**
+ * RateChoicesResponse is a response to the RateChoicesRequest.
+ * @export
+ * @interface V1RateChoicesResponse
+ */
+export interface V1RateChoicesResponse {
+    /**
+     * 
+     * @type {V1JokeInfo}
+     * @memberof V1RateChoicesResponse
+     */
+    leftJoke?: V1JokeInfo;
+    /**
+     * 
+     * @type {V1JokeInfo}
+     * @memberof V1RateChoicesResponse
+     */
+    rightJoke?: V1JokeInfo;
+}
+
+/**
+ * Check if a given object implements the V1RateChoicesResponse interface.
+ */
+export function instanceOfV1RateChoicesResponse(value: object): value is V1RateChoicesResponse {
+    return true;
+}
+
+export function V1RateChoicesResponseFromJSON(json: any): V1RateChoicesResponse {
+    return V1RateChoicesResponseFromJSONTyped(json, false);
+}
+
+export function V1RateChoicesResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean): V1RateChoicesResponse {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'leftJoke': json['leftJoke'] == null ? undefined : V1JokeInfoFromJSON(json['leftJoke']),
+        'rightJoke': json['rightJoke'] == null ? undefined : V1JokeInfoFromJSON(json['rightJoke']),
+    };
+}
+
+export function V1RateChoicesResponseToJSON(value?: V1RateChoicesResponse | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'leftJoke': V1JokeInfoToJSON(value['leftJoke']),
+        'rightJoke': V1JokeInfoToJSON(value['rightJoke']),
+    };
+}
+
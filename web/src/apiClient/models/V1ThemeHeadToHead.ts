@@ -0,0 +1,83 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * proto/server.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { V1HeadToHeadRecord } from './V1HeadToHeadRecord';
+import {
+    V1HeadToHeadRecordFromJSON,
+    V1HeadToHeadRecordFromJSONTyped,
+    V1HeadToHeadRecordToJSON,
+} from './V1HeadToHeadRecord';
+
+/**
+ * ThemeHeadToHead is the part of a head-to-head record from one theme.
+ * @export
+ * @interface V1ThemeHeadToHead
+ */
+export interface V1ThemeHeadToHead {
+    /**
+     * 
+     * @type {string}
+     * @memberof V1ThemeHeadToHead
+     */
+    themeId?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof V1ThemeHeadToHead
+     */
+    theme?: string;
+    /**
+     * 
+     * @type {V1HeadToHeadRecord}
+     * @memberof V1ThemeHeadToHead
+     */
+    record?: V1HeadToHeadRecord;
+}
+
+/**
+ * Check if a given object implements the V1ThemeHeadToHead interface.
+ */
+export function instanceOfV1ThemeHeadToHead(value: object): value is V1ThemeHeadToHead {
+    return true;
+}
+
+export function V1ThemeHeadToHeadFromJSON(json: any): V1ThemeHeadToHead {
+    return V1ThemeHeadToHeadFromJSONTyped(json, false);
+}
+
+export function V1ThemeHeadToHeadFromJSONTyped(json: any, ignoreDiscriminator: boolean): V1ThemeHeadToHead {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'themeId': json['themeId'] == null ? undefined : json['themeId'],
+        'theme': json['theme'] == null ? undefined : json['theme'],
+        'record': json['record'] == null ? undefined : V1HeadToHeadRecordFromJSON(json['record']),
+    };
+}
+
+export function V1ThemeHeadToHeadToJSON(value?: V1ThemeHeadToHead | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'themeId': value['themeId'],
+        'theme': value['theme'],
+        'record': V1HeadToHeadRecordToJSON(value['record']),
+    };
+}
+
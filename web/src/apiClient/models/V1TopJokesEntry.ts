@@ -20,19 +20,72 @@ import { mapValues } from '../runtime';
  */
 export interface V1TopJokesEntry {
     /**
-     * Rank of the joke.
+     * Rank of the joke among all ranked jokes, regardless of filters.
      * @type {string}
      * @memberof V1TopJokesEntry
      */
     rank?: string;
     /**
      * Text of the joke.
-     * 
-     * TODO(rbtz): add model name?
      * @type {string}
      * @memberof V1TopJokesEntry
      */
     text?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof V1TopJokesEntry
+     */
+    jokeId?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof V1TopJokesEntry
+     */
+    themeId?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof V1TopJokesEntry
+     */
+    theme?: string;
+    /**
+     * Model of the joke, named as in LeaderboardEntry.model.
+     * @type {string}
+     * @memberof V1TopJokesEntry
+     */
+    model?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof V1TopJokesEntry
+     */
+    policy?: string;
+    /**
+     * Bradley-Terry score of the joke. A joke with as many wins as losses
+     * scores about one.
+     * @type {number}
+     * @memberof V1TopJokesEntry
+     */
+    score?: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof V1TopJokesEntry
+     */
+    scoreCiLower?: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof V1TopJokesEntry
+     */
+    scoreCiUpper?: number;
+    /**
+     * Number of votes the score is based on.
+     * @type {string}
+     * @memberof V1TopJokesEntry
+     */
+    votes?: string;
 }
 
 /**
@@ -54,6 +107,15 @@ export function V1TopJokesEntryFromJSONTyped(json: any, ignoreDiscriminator: boo
         
         'rank': json['rank'] == null ? undefined : json['rank'],
         'text': json['text'] == null ? undefined : json['text'],
+        'jokeId': json['jokeId'] == null ? undefined : json['jokeId'],
+        'themeId': json['themeId'] == null ? undefined : json['themeId'],
+        'theme': json['theme'] == null ? undefined : json['theme'],
+        'model': json['model'] == null ? undefined : json['model'],
+        'policy': json['policy'] == null ? undefined : json['policy'],
+        'score': json['score'] == null ? undefined : json['score'],
+        'scoreCiLower': json['scoreCiLower'] == null ? undefined : json['scoreCiLower'],
+        'scoreCiUpper': json['scoreCiUpper'] == null ? undefined : json['scoreCiUpper'],
+        'votes': json['votes'] == null ? undefined : json['votes'],
     };
 }
 
@@ -65,6 +127,15 @@ export function V1TopJokesEntryToJSON(value?: V1TopJokesEntry | null): any {
         
         'rank': value['rank'],
         'text': value['text'],
+        'jokeId': value['jokeId'],
+        'themeId': value['themeId'],
+        'theme': value['theme'],
+        'model': value['model'],
+        'policy': value['policy'],
+        'score': value['score'],
+        'scoreCiLower': value['scoreCiLower'],
+        'scoreCiUpper': value['scoreCiUpper'],
+        'votes': value['votes'],
     };
 }
 
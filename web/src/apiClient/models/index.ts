@@ -3,9 +3,25 @@
 export * from './ArenaRateChoicesBody';
 export * from './ProtobufAny';
 export * from './RpcStatus';
+export * from './V1DiffLeaderboardSnapshotsResponse';
 export * from './V1GetChoicesResponse';
+export * from './V1GetHeadToHeadMatrixResponse';
+export * from './V1GetHeadToHeadResponse';
 export * from './V1GetLeaderboardResponse';
+export * from './V1GetModelHistoryResponse';
+export * from './V1GetPositionBiasResponse';
 export * from './V1GetTopJokesResponse';
+export * from './V1HeadToHead';
+export * from './V1HeadToHeadExample';
+export * from './V1HeadToHeadRecord';
+export * from './V1JokeInfo';
+export * from './V1LeaderboardDiffEntry';
 export * from './V1LeaderboardEntry';
+export * from './V1LeaderboardSnapshot';
+export * from './V1ListLeaderboardSnapshotsResponse';
+export * from './V1ModelHistoryPoint';
+export * from './V1PositionBiasEntry';
+export * from './V1RateChoicesResponse';
+export * from './V1ThemeHeadToHead';
 export * from './V1TopJokesEntry';
 export * from './V1Winner';